@@ -0,0 +1,62 @@
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/terraform-providers/terraform-provider-ncloud/ncloud"
+)
+
+// runExport runs the `export` subcommand, which writes Terraform configuration with import blocks of the existing resources in the account.
+// Credentials and the other provider arguments not given as flags are read from the environment variables of the provider.
+func runExport(args []string) error {
+	flags := flag.NewFlagSet("export", flag.ExitOnError)
+	region := flags.String("region", "", "region of the resources. Default NCLOUD_REGION")
+	site := flags.String("site", "", "site of the account, `public`, `gov` or `fin`")
+	profile := flags.String("profile", "", "profile of the credentials file")
+	supportVPC := flags.Bool("support_vpc", false, "export VPC resources instead of classic. Default NCLOUD_SUPPORT_VPC")
+	dir := flags.String("out", ".", "directory of the generated files")
+	resources := flags.String("resources", "", fmt.Sprintf("comma separated resource types to export. Default all supported types: %s", strings.Join(ncloud.ExportResourceTypes(), ", ")))
+	if err := flags.Parse(args); err != nil {
+		return err
+	}
+
+	raw := map[string]interface{}{}
+	flags.Visit(func(f *flag.Flag) {
+		switch f.Name {
+		case "region":
+			raw["region"] = *region
+		case "site":
+			raw["site"] = *site
+		case "profile":
+			raw["profile"] = *profile
+		case "support_vpc":
+			raw["support_vpc"] = *supportVPC
+		}
+	})
+
+	ctx := context.Background()
+	provider := ncloud.Provider()
+	for _, d := range provider.Configure(ctx, terraform.NewResourceConfigRaw(raw)) {
+		if d.Severity == diag.Error {
+			return fmt.Errorf("error configuring provider: %s", d.Summary)
+		}
+	}
+
+	opts := ncloud.ExportOptions{Dir: *dir}
+	if *resources != "" {
+		opts.ResourceTypes = strings.Split(*resources, ",")
+	}
+
+	exported, err := ncloud.Export(ctx, provider, opts)
+	if err != nil {
+		return err
+	}
+
+	fmt.Printf("Exported %d resources to %s\n", len(exported), *dir)
+	return nil
+}
@@ -0,0 +1,146 @@
+package ncloud
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"strings"
+)
+
+// apiErrorHints remediation of known return codes, shown in the detail of diagnostics
+var apiErrorHints = map[string]string{
+	ApiErrorAuthorityParameter:                           "Check `access_key` and `secret_key`, and that the sub account has permission for the API.",
+	ApiErrorObjectInOperation:                            "The target is in operation by another request. Wait until the operation completes and retry.",
+	ApiErrorPortForwardingObjectInOperation:              "Port forwarding rules are being changed by another request. Wait until the operation completes and retry.",
+	ApiErrorServerObjectInOperation:                      "Servers can't be created and terminated at the same time. Wait until the other request completes and retry.",
+	ApiErrorServerObjectInOperation2:                     "The server is in operation by another request. Wait until the operation completes and retry.",
+	ApiErrorPreviousServersHaveNotBeenEntirelyTerminated: "Previously terminated servers are still being returned. Wait a few minutes until they are entirely terminated and retry.",
+	ApiErrorDetachingMountedStorage:                      "The block storage is mounted on the server. Unmount it in the server OS, or stop the server, and detach it first.",
+	ApiErrorNetworkInterfaceAtLeastOneAcgMustRemain:      "A network interface needs at least one ACG. Add another ACG before removing this one.",
+	ApiErrorAcgCantChangeSameTime:                        "Rules of the ACG are being changed by another request. Wait and retry, or manage all rules of an ACG in one `ncloud_access_control_group_rule`.",
+	ApiErrorNetworkAclCantAccessaApropriate:              "The network ACL is being changed by another request. Wait and retry.",
+	ApiErrorNetworkAclRuleChangeIngRules:                 "Rules of the network ACL are being changed by another request. Wait and retry, or manage all rules of a network ACL in one `ncloud_network_acl_rule`.",
+	ApiErrorASGIsUsingPolicyOrLaunchConfiguration:        "The resource is used by an auto scaling group. Delete the auto scaling group or detach the resource from it first.",
+	ApiErrorASGScalingIsActive:                           "Scaling activities of the auto scaling group are in progress. Wait until they complete and retry.",
+	ApiErrorASGIsUsingPolicyOrLaunchConfigurationOnVpc:   "The resource is used by an auto scaling group. Delete the auto scaling group or detach the resource from it first.",
+}
+
+// APIError error response of the ncloud API, returned by the HTTP client of the provider in place of the response
+type APIError struct {
+	StatusCode    int
+	ReturnCode    string
+	ReturnMessage string
+	RequestId     string
+}
+
+func (e *APIError) Error() string {
+	return fmt.Sprintf("[%s] %s", e.ReturnCode, e.ReturnMessage)
+}
+
+// Hint returns remediation of the return code, or empty if unknown
+func (e *APIError) Hint() string {
+	return apiErrorHints[e.ReturnCode]
+}
+
+// detail returns the detail of diagnostics of the error
+func (e *APIError) detail() string {
+	var lines []string
+	if hint := e.Hint(); hint != "" {
+		lines = append(lines, hint, "")
+	}
+
+	lines = append(lines, fmt.Sprintf("HTTP status: %d", e.StatusCode), fmt.Sprintf("Return code: %s", e.ReturnCode))
+	if e.RequestId != "" {
+		lines = append(lines, fmt.Sprintf("Request ID: %s", e.RequestId))
+	}
+
+	return strings.Join(lines, "\n")
+}
+
+// newAPIError returns the API error of the error response body, or nil if the body is not an error of the API
+func newAPIError(statusCode int, body []byte) *APIError {
+	var errBody struct {
+		RequestId     string `json:"requestId"`
+		ResponseError *struct {
+			ReturnCode    string `json:"returnCode"`
+			ReturnMessage string `json:"returnMessage"`
+			RequestId     string `json:"requestId"`
+		} `json:"responseError"`
+		// Error of the API gateway and the APIs of newer services
+		Error *struct {
+			ErrorCode json.RawMessage `json:"errorCode"`
+			Message   string          `json:"message"`
+			Details   string          `json:"details"`
+		} `json:"error"`
+	}
+	if err := json.Unmarshal(body, &errBody); err != nil {
+		return nil
+	}
+
+	apiErr := &APIError{StatusCode: statusCode, RequestId: errBody.RequestId}
+
+	switch {
+	case errBody.ResponseError != nil:
+		apiErr.ReturnCode = errBody.ResponseError.ReturnCode
+		apiErr.ReturnMessage = errBody.ResponseError.ReturnMessage
+		if errBody.ResponseError.RequestId != "" {
+			apiErr.RequestId = errBody.ResponseError.RequestId
+		}
+	case errBody.Error != nil:
+		apiErr.ReturnCode = strings.Trim(string(errBody.Error.ErrorCode), `"`)
+		apiErr.ReturnMessage = errBody.Error.Message
+		if errBody.Error.Details != "" {
+			apiErr.ReturnMessage += ": " + errBody.Error.Details
+		}
+	default:
+		return nil
+	}
+
+	return apiErr
+}
+
+// apiErrorTransport returns error responses of the API as *APIError, so that callers of the SDK get it from errors.As.
+// Error responses without an error body of the API, e.g. HTML of a proxy, are passed to the SDK as is.
+type apiErrorTransport struct {
+	transport http.RoundTripper
+}
+
+func (t *apiErrorTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	resp, err := t.transport.RoundTrip(req)
+	if err != nil || resp.StatusCode < http.StatusBadRequest {
+		return resp, err
+	}
+
+	body, err := ioutil.ReadAll(resp.Body)
+	resp.Body.Close()
+	if err != nil {
+		return nil, err
+	}
+
+	if apiErr := newAPIError(resp.StatusCode, body); apiErr != nil {
+		return nil, apiErr
+	}
+
+	resp.Body = ioutil.NopCloser(bytes.NewReader(body))
+	return resp, nil
+}
+
+// asAPIError returns the API error wrapped in err, or nil if err is not an error response of the API
+func asAPIError(err error) *APIError {
+	var apiErr *APIError
+	if errors.As(err, &apiErr) {
+		return apiErr
+	}
+	return nil
+}
+
+// apiErrorCode returns the return code of the API error, or empty if err is not an error response of the API
+func apiErrorCode(err error) string {
+	if apiErr := asAPIError(err); apiErr != nil {
+		return apiErr.ReturnCode
+	}
+	return ""
+}
@@ -0,0 +1,149 @@
+package ncloud
+
+import (
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/NaverCloudPlatform/ncloud-sdk-go-v2/services/server"
+)
+
+func TestNewAPIError(t *testing.T) {
+	cases := []struct {
+		statusCode    int
+		body          string
+		returnCode    string
+		returnMessage string
+		requestId     string
+	}{
+		{
+			statusCode:    400,
+			body:          `{"responseError": {"returnCode": "24002", "returnMessage": "Detaching mounted storage is not allowed."}}`,
+			returnCode:    "24002",
+			returnMessage: "Detaching mounted storage is not allowed.",
+		},
+		{
+			statusCode:    400,
+			body:          `{"responseError": {"returnCode": "1007009", "returnMessage": "busy", "requestId": "abc"}}`,
+			returnCode:    "1007009",
+			returnMessage: "busy",
+			requestId:     "abc",
+		},
+		{
+			statusCode:    401,
+			body:          `{"error": {"errorCode": "200", "message": "Authentication Failed", "details": "Invalid authentication information."}}`,
+			returnCode:    "200",
+			returnMessage: "Authentication Failed: Invalid authentication information.",
+		},
+		{
+			statusCode:    404,
+			body:          `{"error": {"errorCode": 400, "message": "Not found"}}`,
+			returnCode:    "400",
+			returnMessage: "Not found",
+		},
+	}
+
+	for _, tc := range cases {
+		apiErr := newAPIError(tc.statusCode, []byte(tc.body))
+		if apiErr == nil {
+			t.Fatalf("Expected API error of %s", tc.body)
+		}
+		if apiErr.StatusCode != tc.statusCode || apiErr.ReturnCode != tc.returnCode || apiErr.ReturnMessage != tc.returnMessage || apiErr.RequestId != tc.requestId {
+			t.Fatalf("Expected: %d %s %s %s, Actual: %d %s %s %s", tc.statusCode, tc.returnCode, tc.returnMessage, tc.requestId, apiErr.StatusCode, apiErr.ReturnCode, apiErr.ReturnMessage, apiErr.RequestId)
+		}
+	}
+
+	for _, body := range []string{"", "<html></html>", `{"message": "unknown"}`} {
+		if apiErr := newAPIError(502, []byte(body)); apiErr != nil {
+			t.Fatalf("Expected no API error of %s, Actual: %v", body, apiErr)
+		}
+	}
+
+	for _, err := range []error{nil, errors.New("connection refused"), errors.New(`Status: 400 Bad Request, Body: {"responseError": {"returnCode": "24002"}}`)} {
+		if code := apiErrorCode(err); code != "" {
+			t.Fatalf("Expected empty code of %v, Actual: %s", err, code)
+		}
+	}
+
+	if _, err := GetCommonErrorBody(errors.New("connection refused")); err == nil {
+		t.Fatal("Expected error parsing non API error")
+	}
+}
+
+func TestAPIErrorTransport(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/proxy" {
+			w.WriteHeader(http.StatusBadGateway)
+			w.Write([]byte("<html></html>"))
+			return
+		}
+		w.WriteHeader(http.StatusBadRequest)
+		w.Write([]byte(`{"responseError": {"returnCode": "24002", "returnMessage": "Detaching mounted storage is not allowed."}}`))
+	}))
+	defer ts.Close()
+
+	client := &http.Client{Transport: &apiErrorTransport{transport: http.DefaultTransport}}
+
+	_, err := client.Get(ts.URL + "/server/v2/detachBlockStorageInstances")
+	if apiErr := asAPIError(err); apiErr == nil || apiErr.StatusCode != http.StatusBadRequest || apiErr.ReturnCode != ApiErrorDetachingMountedStorage {
+		t.Fatalf("Expected API error of %s, Actual: %v", ApiErrorDetachingMountedStorage, err)
+	}
+
+	resp, err := client.Get(ts.URL + "/proxy")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer resp.Body.Close()
+
+	if body, _ := ioutil.ReadAll(resp.Body); resp.StatusCode != http.StatusBadGateway || string(body) != "<html></html>" {
+		t.Fatalf("Expected the response of non API error as is, Actual: %d %s", resp.StatusCode, body)
+	}
+}
+
+func TestToDiagnostics_apiError(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusBadRequest)
+		w.Write([]byte(`{"responseError": {"returnCode": "24002", "returnMessage": "Detaching mounted storage is not allowed."}}`))
+	}))
+	defer ts.Close()
+
+	client, err := (&Config{
+		AccessKey: "access",
+		SecretKey: "secret",
+		Region:    "KR",
+		Endpoints: map[string]string{"server": ts.URL + "/server/v2"},
+	}).Client()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	_, err = client.server.V2Api.DetachBlockStorageInstances(&server.DetachBlockStorageInstancesRequest{})
+	if code := apiErrorCode(err); code != ApiErrorDetachingMountedStorage {
+		t.Fatalf("Expected: %s, Actual: %s (%v)", ApiErrorDetachingMountedStorage, code, err)
+	}
+
+	err = fmt.Errorf("error detaching block storage: %w", err)
+	diags := ToDiagnostics(NewArgumentError("server_instance_no", err))
+	if len(diags) != 1 {
+		t.Fatalf("Expected 1 diagnostic, Actual: %v", diags)
+	}
+
+	// The summary keeps the context of the caller without the URL of the request
+	if summary := "error detaching block storage: [24002] Detaching mounted storage is not allowed."; diags[0].Summary != summary {
+		t.Fatalf("Expected: %s, Actual: %s", summary, diags[0].Summary)
+	}
+
+	for _, s := range []string{apiErrorHints[ApiErrorDetachingMountedStorage], "HTTP status: 400", "Return code: 24002"} {
+		if !strings.Contains(diags[0].Detail, s) {
+			t.Fatalf("Expected detail to contain %q, Actual: %s", s, diags[0].Detail)
+		}
+	}
+
+	if diags[0].AttributePath == nil {
+		t.Fatal("Expected attribute path of server_instance_no")
+	}
+}
@@ -0,0 +1,83 @@
+package ncloud
+
+import (
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// BlockStorageService block storage API of the platform selected by `support_vpc` of the provider
+type BlockStorageService interface {
+	// Create requests creation of the block storage from the resource data, as the parameters differ by platform
+	Create(d *schema.ResourceData) (*string, error)
+	// Get returns nil if the block storage is not found
+	Get(id string) (*BlockStorage, error)
+	// GetAdditionalList returns additional block storages attached to the server
+	GetAdditionalList(serverInstanceNo string) ([]*BlockStorage, error)
+	Delete(id string) error
+	Attach(id string, serverInstanceNo string) error
+	Detach(id string) error
+	ChangeSize(id string, size int) error
+}
+
+type classicBlockStorageService struct {
+	config *ProviderConfig
+}
+
+func (s *classicBlockStorageService) Create(d *schema.ResourceData) (*string, error) {
+	return createClassicBlockStorage(d, s.config)
+}
+
+func (s *classicBlockStorageService) Get(id string) (*BlockStorage, error) {
+	return getClassicBlockStorage(s.config, id)
+}
+
+func (s *classicBlockStorageService) GetAdditionalList(serverInstanceNo string) ([]*BlockStorage, error) {
+	return getClassicAdditionalBlockStorageList(s.config, serverInstanceNo)
+}
+
+func (s *classicBlockStorageService) Delete(id string) error {
+	return deleteClassicBlockStorage(s.config, id)
+}
+
+func (s *classicBlockStorageService) Attach(id string, serverInstanceNo string) error {
+	return attachClassicBlockStorage(s.config, id, serverInstanceNo)
+}
+
+func (s *classicBlockStorageService) Detach(id string) error {
+	return detachClassicBlockStorage(s.config, id)
+}
+
+func (s *classicBlockStorageService) ChangeSize(id string, size int) error {
+	return changeClassicBlockStorageSize(s.config, id, size)
+}
+
+type vpcBlockStorageService struct {
+	config *ProviderConfig
+}
+
+func (s *vpcBlockStorageService) Create(d *schema.ResourceData) (*string, error) {
+	return createVpcBlockStorage(d, s.config)
+}
+
+func (s *vpcBlockStorageService) Get(id string) (*BlockStorage, error) {
+	return getVpcBlockStorage(s.config, id)
+}
+
+func (s *vpcBlockStorageService) GetAdditionalList(serverInstanceNo string) ([]*BlockStorage, error) {
+	return getVpcAdditionalBlockStorageList(s.config, serverInstanceNo)
+}
+
+func (s *vpcBlockStorageService) Delete(id string) error {
+	return deleteVpcBlockStorage(s.config, id)
+}
+
+func (s *vpcBlockStorageService) Attach(id string, serverInstanceNo string) error {
+	return attachVpcBlockStorage(s.config, id, serverInstanceNo)
+}
+
+func (s *vpcBlockStorageService) Detach(id string) error {
+	return detachVpcBlockStorage(s.config, id)
+}
+
+func (s *vpcBlockStorageService) ChangeSize(id string, size int) error {
+	return changeVpcBlockStorageSize(s.config, id, size)
+}
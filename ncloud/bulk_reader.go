@@ -0,0 +1,214 @@
+package ncloud
+
+import (
+	"sync"
+	"time"
+)
+
+const (
+	// DefaultBulkReadWindow time a lookup waits for the lookups of concurrent reads to join its list call
+	DefaultBulkReadWindow = 50 * time.Millisecond
+	// DefaultBulkReadBatchSize maximum number of instances of a list call
+	DefaultBulkReadBatchSize = 100
+)
+
+// bulkReader read-through cache of instances, kept for the run of the provider.
+// Lookups of concurrent reads are batched into one list call, and instances not found are cached as nil.
+// Entries are valid until Generation changes, which happens on mutation.
+type bulkReader[T any] struct {
+	// List returns the instances found of the ids
+	List func(ids []string) ([]*T, error)
+	// Lookup returns the instance of the id, or nil if not found. Ids missing from a list call are confirmed by Lookup,
+	// so that an incomplete list never removes a resource from the state.
+	Lookup func(id string) (*T, error)
+	// ID returns the id of the instance
+	ID func(instance *T) string
+	// Generation returns the number of mutations so far. Nil if instances are never mutated.
+	Generation func() int64
+
+	Window    time.Duration
+	BatchSize int
+
+	mu      sync.Mutex
+	entries map[string]*bulkReadEntry[T]
+	batch   *bulkReadBatch[T]
+}
+
+type bulkReadEntry[T any] struct {
+	instance   *T
+	generation int64
+}
+
+// bulkReadBatch ids of a list call, and its result once done is closed
+type bulkReadBatch[T any] struct {
+	ids  []string
+	full chan struct{}
+	done chan struct{}
+
+	instances map[string]*T
+	err       error
+}
+
+// Get returns the instance of the id, or nil if not found
+func (r *bulkReader[T]) Get(id string) (*T, error) {
+	instances, err := r.GetList([]string{id})
+	if err != nil {
+		return nil, err
+	}
+
+	return instances[id], nil
+}
+
+// GetList returns shallow copies of the instances of the ids by id. Ids not found are mapped to nil.
+func (r *bulkReader[T]) GetList(ids []string) (map[string]*T, error) {
+	result := make(map[string]*T, len(ids))
+	pending := map[string]*bulkReadBatch[T]{}
+	generation := r.generation()
+
+	r.mu.Lock()
+	for _, id := range ids {
+		if entry, ok := r.entries[id]; ok && entry.generation == generation {
+			result[id] = entry.instance
+			continue
+		}
+		if _, ok := pending[id]; !ok {
+			pending[id] = r.enqueue(id)
+		}
+	}
+	r.mu.Unlock()
+
+	for id, batch := range pending {
+		<-batch.done
+		if batch.err != nil {
+			return nil, batch.err
+		}
+		result[id] = batch.instances[id]
+	}
+
+	// Callers may change the fields of the instances, so they get copies of the cached ones
+	for id, instance := range result {
+		if instance != nil {
+			copied := *instance
+			result[id] = &copied
+		}
+	}
+
+	return result, nil
+}
+
+// enqueue adds the id to the open batch, starting a new one if none. r.mu must be held.
+func (r *bulkReader[T]) enqueue(id string) *bulkReadBatch[T] {
+	batch := r.batch
+	if batch == nil {
+		batch = &bulkReadBatch[T]{
+			full: make(chan struct{}),
+			done: make(chan struct{}),
+		}
+		r.batch = batch
+		go r.run(batch)
+	}
+
+	for _, v := range batch.ids {
+		if v == id {
+			return batch
+		}
+	}
+
+	batch.ids = append(batch.ids, id)
+	if len(batch.ids) >= r.batchSize() {
+		r.batch = nil
+		close(batch.full)
+	}
+
+	return batch
+}
+
+// run lists the instances of the batch once the window passed or the batch is full, and caches them
+func (r *bulkReader[T]) run(batch *bulkReadBatch[T]) {
+	defer close(batch.done)
+
+	timer := time.NewTimer(r.window())
+	defer timer.Stop()
+
+	select {
+	case <-timer.C:
+	case <-batch.full:
+	}
+
+	r.mu.Lock()
+	if r.batch == batch {
+		r.batch = nil
+	}
+	r.mu.Unlock()
+
+	// Instances listed before a mutation are stale after it
+	generation := r.generation()
+
+	instances, err := r.list(batch.ids)
+	if err != nil {
+		batch.err = err
+		return
+	}
+	batch.instances = instances
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if r.entries == nil {
+		r.entries = map[string]*bulkReadEntry[T]{}
+	}
+
+	for id, instance := range instances {
+		r.entries[id] = &bulkReadEntry[T]{instance: instance, generation: generation}
+	}
+}
+
+// list returns the instances of the ids by id, looking up the ones missing from the list call
+func (r *bulkReader[T]) list(ids []string) (map[string]*T, error) {
+	list, err := r.List(ids)
+	if err != nil {
+		return nil, err
+	}
+
+	instances := make(map[string]*T, len(ids))
+	for _, instance := range list {
+		if instance != nil {
+			instances[r.ID(instance)] = instance
+		}
+	}
+
+	for _, id := range ids {
+		if _, ok := instances[id]; ok {
+			continue
+		}
+
+		instance, err := r.Lookup(id)
+		if err != nil {
+			return nil, err
+		}
+		instances[id] = instance
+	}
+
+	return instances, nil
+}
+
+func (r *bulkReader[T]) generation() int64 {
+	if r.Generation == nil {
+		return 0
+	}
+	return r.Generation()
+}
+
+func (r *bulkReader[T]) window() time.Duration {
+	if r.Window > 0 {
+		return r.Window
+	}
+	return DefaultBulkReadWindow
+}
+
+func (r *bulkReader[T]) batchSize() int {
+	if r.BatchSize > 0 {
+		return r.BatchSize
+	}
+	return DefaultBulkReadBatchSize
+}
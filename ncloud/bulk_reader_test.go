@@ -0,0 +1,210 @@
+package ncloud
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+)
+
+type testBulkReadInstance struct {
+	id   string
+	name string
+}
+
+// testBulkReader returns a reader of the instances, recording the ids of each list call and lookup
+func testBulkReader(instances map[string]*testBulkReadInstance) (*bulkReader[testBulkReadInstance], *[]string, *[]string) {
+	var mu sync.Mutex
+	var lists, lookups []string
+
+	return &bulkReader[testBulkReadInstance]{
+		List: func(ids []string) ([]*testBulkReadInstance, error) {
+			mu.Lock()
+			defer mu.Unlock()
+
+			sorted := append([]string{}, ids...)
+			sort.Strings(sorted)
+			lists = append(lists, strings.Join(sorted, ","))
+
+			var list []*testBulkReadInstance
+			for _, id := range ids {
+				if instance, ok := instances[id]; ok {
+					list = append(list, instance)
+				}
+			}
+			return list, nil
+		},
+		Lookup: func(id string) (*testBulkReadInstance, error) {
+			mu.Lock()
+			defer mu.Unlock()
+
+			lookups = append(lookups, id)
+			return instances[id], nil
+		},
+		ID: func(instance *testBulkReadInstance) string {
+			return instance.id
+		},
+		Window: 20 * time.Millisecond,
+	}, &lists, &lookups
+}
+
+func testBulkReadInstances(n int) map[string]*testBulkReadInstance {
+	instances := map[string]*testBulkReadInstance{}
+	for i := 1; i <= n; i++ {
+		id := fmt.Sprint(i)
+		instances[id] = &testBulkReadInstance{id: id, name: "instance-" + id}
+	}
+	return instances
+}
+
+// testBulkReadConcurrently gets the ids concurrently, failing the test on error or wrong instance
+func testBulkReadConcurrently(t *testing.T, r *bulkReader[testBulkReadInstance], ids ...string) {
+	var wg sync.WaitGroup
+	for _, id := range ids {
+		wg.Add(1)
+		go func(id string) {
+			defer wg.Done()
+
+			instance, err := r.Get(id)
+			if err != nil {
+				t.Errorf("Unexpected error: %s", err)
+				return
+			}
+			if instance == nil || instance.id != id {
+				t.Errorf("Expected: %s, Actual: %v", id, instance)
+			}
+		}(id)
+	}
+	wg.Wait()
+}
+
+func TestBulkReader_batch(t *testing.T) {
+	r, lists, _ := testBulkReader(testBulkReadInstances(5))
+	r.Window = 200 * time.Millisecond
+
+	testBulkReadConcurrently(t, r, "1", "2", "3", "4", "5")
+
+	if expected := []string{"1,2,3,4,5"}; strings.Join(*lists, " ") != strings.Join(expected, " ") {
+		t.Fatalf("Expected: %v, Actual: %v", expected, *lists)
+	}
+
+	// Cached for the run
+	testBulkReadConcurrently(t, r, "1", "3")
+	if len(*lists) != 1 {
+		t.Fatalf("Expected: 1 list call, Actual: %v", *lists)
+	}
+}
+
+func TestBulkReader_batchSize(t *testing.T) {
+	r, lists, _ := testBulkReader(testBulkReadInstances(5))
+	r.BatchSize = 2
+	r.Window = time.Minute
+
+	// Full batches are listed without waiting for the window
+	start := time.Now()
+	instances, err := r.GetList([]string{"1", "2", "3", "4"})
+	if err != nil {
+		t.Fatalf("Unexpected error: %s", err)
+	}
+
+	if elapsed := time.Since(start); elapsed > 10*time.Second {
+		t.Fatalf("Expected full batches listed immediately, Actual: %s", elapsed)
+	}
+
+	if len(instances) != 4 {
+		t.Fatalf("Expected: 4, Actual: %d", len(instances))
+	}
+
+	sort.Strings(*lists)
+	if expected := "1,2 3,4"; strings.Join(*lists, " ") != expected {
+		t.Fatalf("Expected: %s, Actual: %v", expected, *lists)
+	}
+}
+
+func TestBulkReader_notFound(t *testing.T) {
+	r, lists, lookups := testBulkReader(testBulkReadInstances(1))
+
+	instances, err := r.GetList([]string{"1", "2"})
+	if err != nil {
+		t.Fatalf("Unexpected error: %s", err)
+	}
+
+	if instances["1"] == nil || instances["2"] != nil {
+		t.Fatalf("Expected only 1 found, Actual: %v", instances)
+	}
+
+	// Ids missing from the list call are confirmed one by one
+	if strings.Join(*lookups, ",") != "2" {
+		t.Fatalf("Expected: 2, Actual: %v", *lookups)
+	}
+
+	// Not found is cached as well
+	if instance, err := r.Get("2"); err != nil || instance != nil {
+		t.Fatalf("Expected not found, Actual: %v, %v", instance, err)
+	}
+
+	if len(*lists) != 1 || len(*lookups) != 1 {
+		t.Fatalf("Expected no more calls, Actual: %v, %v", *lists, *lookups)
+	}
+}
+
+func TestBulkReader_generation(t *testing.T) {
+	instances := testBulkReadInstances(1)
+	r, lists, _ := testBulkReader(instances)
+
+	var generation int64
+	r.Generation = func() int64 {
+		return generation
+	}
+
+	if instance, _ := r.Get("1"); instance.name != "instance-1" {
+		t.Fatalf("Expected: instance-1, Actual: %s", instance.name)
+	}
+
+	instances["1"].name = "renamed"
+	generation++
+
+	if instance, _ := r.Get("1"); instance.name != "renamed" {
+		t.Fatalf("Expected: renamed, Actual: %s", instance.name)
+	}
+
+	if len(*lists) != 2 {
+		t.Fatalf("Expected: 2 list calls, Actual: %v", *lists)
+	}
+}
+
+func TestBulkReader_error(t *testing.T) {
+	r, _, _ := testBulkReader(testBulkReadInstances(1))
+	expected := errors.New("list error")
+	list := r.List
+	r.List = func(ids []string) ([]*testBulkReadInstance, error) {
+		return nil, expected
+	}
+
+	if _, err := r.Get("1"); err != expected {
+		t.Fatalf("Expected: %s, Actual: %v", expected, err)
+	}
+
+	// Errors are not cached
+	r.List = list
+	if instance, err := r.Get("1"); err != nil || instance == nil {
+		t.Fatalf("Expected instance, Actual: %v, %v", instance, err)
+	}
+}
+
+func TestBulkReader_copy(t *testing.T) {
+	r, _, _ := testBulkReader(testBulkReadInstances(1))
+
+	instance, err := r.Get("1")
+	if err != nil {
+		t.Fatalf("Unexpected error: %s", err)
+	}
+	instance.name = "changed"
+
+	if instance, _ := r.Get("1"); instance.name != "instance-1" {
+		t.Fatalf("Expected: instance-1, Actual: %s", instance.name)
+	}
+}
@@ -1,7 +1,6 @@
 package ncloud
 
 import (
-	"encoding/json"
 	"fmt"
 	"log"
 
@@ -68,18 +67,15 @@ type CommonError struct {
 }
 
 func logErrorResponse(tag string, err error, args interface{}) {
-	param, _ := json.Marshal(args)
-	log.Printf("[ERROR] %s error params=%s, err=%s", tag, param, err)
+	log.Printf("[ERROR] %s error params=%s, err=%s", tag, redactLogValue(args), err)
 }
 
 func logCommonRequest(tag string, args interface{}) {
-	param, _ := json.Marshal(args)
-	log.Printf("[INFO] %s params=%s", tag, param)
+	log.Printf("[INFO] %s params=%s", tag, redactLogValue(args))
 }
 
 func logResponse(tag string, args interface{}) {
-	resp, _ := json.Marshal(args)
-	log.Printf("[INFO] %s response=%s", tag, resp)
+	log.Printf("[INFO] %s response=%s", tag, redactLogValue(args))
 }
 
 func logCommonResponse(tag string, commonResponse *CommonResponse, logs ...string) {
@@ -1,6 +1,14 @@
 package ncloud
 
 import (
+	"crypto/tls"
+	"crypto/x509"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"strings"
+
 	"github.com/NaverCloudPlatform/ncloud-sdk-go-v2/services/vautoscaling"
 	"github.com/NaverCloudPlatform/ncloud-sdk-go-v2/services/vloadbalancer"
 	"github.com/NaverCloudPlatform/ncloud-sdk-go-v2/services/vnks"
@@ -24,19 +32,54 @@ import (
 	"github.com/NaverCloudPlatform/ncloud-sdk-go-v2/services/vsourcepipeline"
 )
 
-// DefaultWaitForInterval is Interval for checking status in WaitForXXX method
-const DefaultWaitForInterval = 10
-
 // Default timeout
 const DefaultTimeout = 5 * time.Minute
 const DefaultCreateTimeout = 1 * time.Hour
 const DefaultUpdateTimeout = 10 * time.Minute
 const DefaultStopTimeout = 5 * time.Minute
 
+// API gateway of each site
+const (
+	PublicApiGateway = "https://ncloud.apigw.ntruss.com"
+	GovApiGateway    = "https://ncloud.apigw.gov-ntruss.com"
+	FinApiGateway    = "https://fin-ncloud.apigw.fin-ntruss.com"
+)
+
+// EndpointServices services of which base URL can be set in `endpoints` of the provider
+var EndpointServices = []string{
+	"server",
+	"autoscaling",
+	"loadbalancer",
+	"cdn",
+	"clouddb",
+	"monitoring",
+	"vpc",
+	"vserver",
+	"vnas",
+	"vautoscaling",
+	"vloadbalancer",
+	"vnks",
+	"sourcecommit",
+	"sourcebuild",
+	"sourcepipeline",
+	"vsourcedeploy",
+	"vsourcepipeline",
+}
+
 type Config struct {
-	AccessKey string
-	SecretKey string
-	Region    string
+	AccessKey  string
+	SecretKey  string
+	Region     string
+	Site       string
+	ApiGateway string
+	Endpoints  map[string]string
+	Insecure   bool
+	CABundle   string
+	HTTPProxy  string
+	MaxRetries int
+
+	ApiRateLimit      float64
+	ApiRateLimitBurst int
 }
 
 type NcloudAPIClient struct {
@@ -52,11 +95,17 @@ type NcloudAPIClient struct {
 	vautoscaling    *vautoscaling.APIClient
 	vloadbalancer   *vloadbalancer.APIClient
 	vnks            *vnks.APIClient
+	vnksExt         *nksExtAPIClient
 	sourcecommit    *sourcecommit.APIClient
 	sourcebuild     *sourcebuild.APIClient
 	sourcepipeline  *sourcepipeline.APIClient
 	vsourcepipeline *vsourcepipeline.APIClient
 	vsourcedeploy   *vsourcedeploy.APIClient
+
+	// mutations counts the API calls changing instances, which invalidate the read cache
+	mutations *mutationCounter
+	// transport builds transports with the TLS and proxy settings of the provider for the clients outside of the SDK
+	transport func(certificateAuthority []byte) (*http.Transport, error)
 }
 
 func (c *Config) Client() (*NcloudAPIClient, error) {
@@ -64,31 +113,195 @@ func (c *Config) Client() (*NcloudAPIClient, error) {
 		AccessKey: c.AccessKey,
 		SecretKey: c.SecretKey,
 	}
+	limiter := newRateLimiter(c.ApiRateLimit, c.ApiRateLimitBurst)
+	mutations := &mutationCounter{}
+	httpClient, err := c.httpClient(limiter, mutations)
+	if err != nil {
+		return nil, err
+	}
+
+	apiGateway := c.apiGateway()
+	configure := func(cfg *ncloud.Configuration, service string, basePath string) *ncloud.Configuration {
+		cfg.BasePath = basePath
+		if endpoint := c.Endpoints[service]; endpoint != "" {
+			cfg.BasePath = strings.TrimSuffix(endpoint, "/")
+		}
+		cfg.HTTPClient = httpClient
+		return cfg
+	}
+
+	vnksConfig := configure(vnks.NewConfiguration(c.Region, apiKey), "vnks", nksBasePath(apiGateway, c.Region))
 	return &NcloudAPIClient{
-		server:          server.NewAPIClient(server.NewConfiguration(apiKey)),
-		autoscaling:     autoscaling.NewAPIClient(autoscaling.NewConfiguration(apiKey)),
-		loadbalancer:    loadbalancer.NewAPIClient(loadbalancer.NewConfiguration(apiKey)),
-		cdn:             cdn.NewAPIClient(cdn.NewConfiguration(apiKey)),
-		clouddb:         clouddb.NewAPIClient(clouddb.NewConfiguration(apiKey)),
-		monitoring:      monitoring.NewAPIClient(monitoring.NewConfiguration(apiKey)),
-		vpc:             vpc.NewAPIClient(vpc.NewConfiguration(apiKey)),
-		vserver:         vserver.NewAPIClient(vserver.NewConfiguration(apiKey)),
-		vnas:            vnas.NewAPIClient(vnas.NewConfiguration(apiKey)),
-		vautoscaling:    vautoscaling.NewAPIClient(vautoscaling.NewConfiguration(apiKey)),
-		vloadbalancer:   vloadbalancer.NewAPIClient(vloadbalancer.NewConfiguration(apiKey)),
-		vnks:            vnks.NewAPIClient(vnks.NewConfiguration(c.Region, apiKey)),
-		sourcecommit:    sourcecommit.NewAPIClient(sourcecommit.NewConfiguration(c.Region, apiKey)),
-		sourcebuild:     sourcebuild.NewAPIClient((sourcebuild.NewConfiguration(c.Region, apiKey))),
-		sourcepipeline:  sourcepipeline.NewAPIClient(sourcepipeline.NewConfiguration(c.Region, apiKey)),
-		vsourcedeploy:   vsourcedeploy.NewAPIClient(vsourcedeploy.NewConfiguration(c.Region, apiKey)),
-		vsourcepipeline: vsourcepipeline.NewAPIClient(vsourcepipeline.NewConfiguration(c.Region, apiKey)),
+		server:          server.NewAPIClient(configure(server.NewConfiguration(apiKey), "server", apiGateway+"/server/v2")),
+		autoscaling:     autoscaling.NewAPIClient(configure(autoscaling.NewConfiguration(apiKey), "autoscaling", apiGateway+"/autoscaling/v2")),
+		loadbalancer:    loadbalancer.NewAPIClient(configure(loadbalancer.NewConfiguration(apiKey), "loadbalancer", apiGateway+"/loadbalancer/v2")),
+		cdn:             cdn.NewAPIClient(configure(cdn.NewConfiguration(apiKey), "cdn", apiGateway+"/cdn/v2")),
+		clouddb:         clouddb.NewAPIClient(configure(clouddb.NewConfiguration(apiKey), "clouddb", apiGateway+"/clouddb/v2")),
+		monitoring:      monitoring.NewAPIClient(configure(monitoring.NewConfiguration(apiKey), "monitoring", apiGateway+"/monitoring/v2")),
+		vpc:             vpc.NewAPIClient(configure(vpc.NewConfiguration(apiKey), "vpc", apiGateway+"/vpc/v2")),
+		vserver:         vserver.NewAPIClient(configure(vserver.NewConfiguration(apiKey), "vserver", apiGateway+"/vserver/v2")),
+		vnas:            vnas.NewAPIClient(configure(vnas.NewConfiguration(apiKey), "vnas", apiGateway+"/vnas/v2")),
+		vautoscaling:    vautoscaling.NewAPIClient(configure(vautoscaling.NewConfiguration(apiKey), "vautoscaling", apiGateway+"/vautoscaling/v2")),
+		vloadbalancer:   vloadbalancer.NewAPIClient(configure(vloadbalancer.NewConfiguration(apiKey), "vloadbalancer", apiGateway+"/vloadbalancer/v2")),
+		vnks:            vnks.NewAPIClient(vnksConfig),
+		vnksExt:         newNKSExtAPIClient(vnksConfig),
+		sourcecommit:    sourcecommit.NewAPIClient(configure(sourcecommit.NewConfiguration(c.Region, apiKey), "sourcecommit", devtoolsBasePath(apiGateway, "sourcecommit", c.Region))),
+		sourcebuild:     sourcebuild.NewAPIClient(configure(sourcebuild.NewConfiguration(c.Region, apiKey), "sourcebuild", devtoolsBasePath(apiGateway, "sourcebuild", c.Region))),
+		sourcepipeline:  sourcepipeline.NewAPIClient(configure(sourcepipeline.NewConfiguration(c.Region, apiKey), "sourcepipeline", devtoolsBasePath(apiGateway, "sourcepipeline", c.Region))),
+		vsourcedeploy:   vsourcedeploy.NewAPIClient(configure(vsourcedeploy.NewConfiguration(c.Region, apiKey), "vsourcedeploy", devtoolsBasePath(apiGateway, "vpcsourcedeploy", c.Region))),
+		vsourcepipeline: vsourcepipeline.NewAPIClient(configure(vsourcepipeline.NewConfiguration(c.Region, apiKey), "vsourcepipeline", devtoolsBasePath(apiGateway, "vpcsourcepipeline", c.Region))),
+		mutations:       mutations,
+		transport:       c.transport,
 	}, nil
 }
 
+// apiGateway returns API gateway of the provider instance.
+// The SDK reads it from NCLOUD_API_GW, which is shared by all provider instances in the process, so base paths are always set explicitly.
+func (c *Config) apiGateway() string {
+	switch c.Site {
+	case "gov":
+		return GovApiGateway
+	case "fin":
+		return FinApiGateway
+	}
+
+	if c.ApiGateway != "" {
+		return strings.TrimSuffix(c.ApiGateway, "/")
+	}
+
+	return PublicApiGateway
+}
+
+// transport returns HTTP transport with the TLS and proxy settings of the provider.
+// certificateAuthority replaces the system roots when given, for the clients of the endpoints signed by their own CA such as NKS clusters.
+func (c *Config) transport(certificateAuthority []byte) (*http.Transport, error) {
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+	transport.TLSClientConfig = &tls.Config{InsecureSkipVerify: c.Insecure}
+
+	if len(certificateAuthority) > 0 || c.CABundle != "" {
+		var pool *x509.CertPool
+		if len(certificateAuthority) > 0 {
+			pool = x509.NewCertPool()
+			if !pool.AppendCertsFromPEM(certificateAuthority) {
+				return nil, fmt.Errorf("no certificate found in certificate authority")
+			}
+		} else if pool, _ = x509.SystemCertPool(); pool == nil {
+			pool = x509.NewCertPool()
+		}
+
+		if c.CABundle != "" {
+			pem, err := ioutil.ReadFile(c.CABundle)
+			if err != nil {
+				return nil, fmt.Errorf("error reading ca_bundle (%s): %s", c.CABundle, err)
+			}
+			if !pool.AppendCertsFromPEM(pem) {
+				return nil, fmt.Errorf("no certificate found in ca_bundle (%s)", c.CABundle)
+			}
+		}
+		transport.TLSClientConfig.RootCAs = pool
+	}
+
+	if c.HTTPProxy != "" {
+		proxyURL, err := url.Parse(c.HTTPProxy)
+		if err != nil {
+			return nil, fmt.Errorf("error parsing http_proxy (%s): %s", c.HTTPProxy, err)
+		}
+		transport.Proxy = http.ProxyURL(proxyURL)
+	}
+
+	return transport, nil
+}
+
+// httpClient returns HTTP client shared by the SDK clients of the provider instance
+func (c *Config) httpClient(limiter *rateLimiter, mutations *mutationCounter) (*http.Client, error) {
+	transport, err := c.transport(nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var roundTripper http.RoundTripper = transport
+	if level := providerLogLevel(); level != "" {
+		roundTripper = &loggingTransport{transport: roundTripper, level: level}
+	}
+
+	if limiter != nil {
+		roundTripper = &rateLimitTransport{transport: roundTripper, limiter: limiter}
+	}
+
+	roundTripper = &apiErrorTransport{transport: newRetryTransport(roundTripper, c.MaxRetries)}
+	if mutations != nil {
+		roundTripper = &mutationTransport{transport: roundTripper, mutations: mutations}
+	}
+
+	return &http.Client{Transport: roundTripper}, nil
+}
+
+// nksBasePath follows vnks.NewConfiguration
+func nksBasePath(apiGateway string, region string) string {
+	apiGateway = strings.Replace(apiGateway, "https://ncloud.", "https://nks.", 1)
+	apiGateway = strings.Replace(apiGateway, "https://fin-ncloud.", "https://nks.", 1)
+
+	switch region {
+	case "KR":
+		return fmt.Sprintf("%s/vnks/v2", apiGateway)
+	case "FKR":
+		return fmt.Sprintf("%s/nks/v2", apiGateway)
+	default:
+		return fmt.Sprintf("%s/vnks/%s-v2", apiGateway, strings.ToLower(region))
+	}
+}
+
+// devtoolsBasePath follows NewConfiguration of sourcecommit, sourcebuild, sourcepipeline, vsourcedeploy and vsourcepipeline
+func devtoolsBasePath(apiGateway string, host string, region string) string {
+	basePath := apiGateway + "/api/v1"
+	basePath = strings.Replace(basePath, "fin-ncloud", host, 1)
+	basePath = strings.Replace(basePath, "ncloud", host, 1)
+
+	if region == "SGN" {
+		basePath = strings.Replace(basePath, "/v1", "/sg-v1", 1)
+	}
+
+	return basePath
+}
+
 type ProviderConfig struct {
-	Site       string
-	SupportVPC bool
-	RegionCode string
-	RegionNo   string
-	Client     *NcloudAPIClient
+	Site        string
+	ApiGateway  string
+	SupportVPC  bool
+	RegionCode  string
+	DefaultTags map[string]string
+	Client      *NcloudAPIClient
+
+	ServerService       ServerService
+	BlockStorageService BlockStorageService
+	PublicIpService     PublicIpService
+
+	regionCache *regionCache
+	// readCache instances read on refresh, nil to read through the services
+	readCache *readCache
+	// platformConfigs configs of the platform other than the provider's, selected by `platform` of resources
+	platformConfigs map[string]*ProviderConfig
+}
+
+// setPlatformServices selects the implementations of the services by `support_vpc`
+func (c *ProviderConfig) setPlatformServices() {
+	if c.SupportVPC {
+		c.ServerService = &vpcServerService{config: c}
+		c.BlockStorageService = &vpcBlockStorageService{config: c}
+		c.PublicIpService = &vpcPublicIpService{config: c}
+	} else {
+		c.ServerService = &classicServerService{config: c}
+		c.BlockStorageService = &classicBlockStorageService{config: c}
+		c.PublicIpService = &classicPublicIpService{config: c}
+	}
+
+	c.readCache = newReadCache(c)
+}
+
+// regionNo returns region number of the provider region on Classic, loaded on first use
+func (c *ProviderConfig) regionNo() (*string, error) {
+	c.regionCache.mu.Lock()
+	defer c.regionCache.mu.Unlock()
+
+	return c.regionCache.regionNo()
 }
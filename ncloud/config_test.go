@@ -0,0 +1,251 @@
+package ncloud
+
+import (
+	"encoding/pem"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/NaverCloudPlatform/ncloud-sdk-go-v2/ncloud"
+	"github.com/NaverCloudPlatform/ncloud-sdk-go-v2/services/server"
+)
+
+func TestConfig_apiGateway(t *testing.T) {
+	cases := []struct {
+		config   Config
+		expected string
+	}{
+		{Config{}, PublicApiGateway},
+		{Config{Site: "public"}, PublicApiGateway},
+		{Config{Site: "gov"}, GovApiGateway},
+		{Config{Site: "fin"}, FinApiGateway},
+		{Config{ApiGateway: "https://ncloud.apigw.example.com/"}, "https://ncloud.apigw.example.com"},
+		{Config{Site: "fin", ApiGateway: "https://ncloud.apigw.example.com"}, FinApiGateway},
+	}
+
+	for _, tc := range cases {
+		if actual := tc.config.apiGateway(); actual != tc.expected {
+			t.Fatalf("Expected: %s, Actual: %s", tc.expected, actual)
+		}
+	}
+}
+
+func TestNksBasePath(t *testing.T) {
+	cases := []struct {
+		apiGateway string
+		region     string
+		expected   string
+	}{
+		{PublicApiGateway, "KR", "https://nks.apigw.ntruss.com/vnks/v2"},
+		{PublicApiGateway, "JPN", "https://nks.apigw.ntruss.com/vnks/jpn-v2"},
+		{GovApiGateway, "KR", "https://nks.apigw.gov-ntruss.com/vnks/v2"},
+		{FinApiGateway, "FKR", "https://nks.apigw.fin-ntruss.com/nks/v2"},
+	}
+
+	for _, tc := range cases {
+		if actual := nksBasePath(tc.apiGateway, tc.region); actual != tc.expected {
+			t.Fatalf("Expected: %s, Actual: %s", tc.expected, actual)
+		}
+	}
+}
+
+func TestDevtoolsBasePath(t *testing.T) {
+	cases := []struct {
+		apiGateway string
+		host       string
+		region     string
+		expected   string
+	}{
+		{PublicApiGateway, "sourcecommit", "KR", "https://sourcecommit.apigw.ntruss.com/api/v1"},
+		{PublicApiGateway, "sourcebuild", "SGN", "https://sourcebuild.apigw.ntruss.com/api/sg-v1"},
+		{FinApiGateway, "vpcsourcedeploy", "FKR", "https://vpcsourcedeploy.apigw.fin-ntruss.com/api/v1"},
+	}
+
+	for _, tc := range cases {
+		if actual := devtoolsBasePath(tc.apiGateway, tc.host, tc.region); actual != tc.expected {
+			t.Fatalf("Expected: %s, Actual: %s", tc.expected, actual)
+		}
+	}
+}
+
+func TestConfig_ClientPerInstance(t *testing.T) {
+	// NCLOUD_API_GW read by the SDK must not change base paths of the client
+	os.Setenv("NCLOUD_API_GW", GovApiGateway)
+	defer os.Unsetenv("NCLOUD_API_GW")
+
+	public, err := (&Config{AccessKey: "access", SecretKey: "secret", Region: "JPN"}).Client()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	fin, err := (&Config{AccessKey: "access", SecretKey: "secret", Region: "FKR", Site: "fin"}).Client()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if expected := "https://nks.apigw.ntruss.com/vnks/jpn-v2"; public.vnksExt.cfg.BasePath != expected {
+		t.Fatalf("Expected: %s, Actual: %s", expected, public.vnksExt.cfg.BasePath)
+	}
+
+	if expected := "https://nks.apigw.fin-ntruss.com/nks/v2"; fin.vnksExt.cfg.BasePath != expected {
+		t.Fatalf("Expected: %s, Actual: %s", expected, fin.vnksExt.cfg.BasePath)
+	}
+}
+
+func testRegionListHandler(t *testing.T, path string) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != path {
+			t.Errorf("Expected path: %s, Actual: %s", path, r.URL.Path)
+		}
+		w.Write([]byte(`{"getRegionListResponse": {"returnCode":"0","regionList":[{"regionNo":"1","regionCode":"KR"}]}}`))
+	}
+}
+
+func testGetRegionCode(t *testing.T, client *NcloudAPIClient) string {
+	resp, err := client.server.V2Api.GetRegionList(&server.GetRegionListRequest{})
+	if err != nil {
+		t.Fatalf("Unexpected error: %s", err)
+	}
+
+	if len(resp.RegionList) != 1 {
+		t.Fatalf("Expected 1 region, Actual: %d", len(resp.RegionList))
+	}
+	return ncloud.StringValue(resp.RegionList[0].RegionCode)
+}
+
+func TestConfig_ClientEndpoints(t *testing.T) {
+	ts := httptest.NewServer(testRegionListHandler(t, "/mock/server/v2/getRegionList"))
+	defer ts.Close()
+
+	client, err := (&Config{
+		AccessKey: "access",
+		SecretKey: "secret",
+		Region:    "KR",
+		Endpoints: map[string]string{"server": ts.URL + "/mock/server/v2/"},
+	}).Client()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if code := testGetRegionCode(t, client); code != "KR" {
+		t.Fatalf("Expected: KR, Actual: %s", code)
+	}
+}
+
+func TestConfig_ClientInsecure(t *testing.T) {
+	ts := httptest.NewTLSServer(testRegionListHandler(t, "/server/v2/getRegionList"))
+	defer ts.Close()
+
+	config := &Config{
+		AccessKey: "access",
+		SecretKey: "secret",
+		Region:    "KR",
+		Endpoints: map[string]string{"server": ts.URL + "/server/v2"},
+	}
+
+	client, err := config.Client()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err := client.server.V2Api.GetRegionList(&server.GetRegionListRequest{}); err == nil {
+		t.Fatal("Expected error for untrusted certificate")
+	}
+
+	config.Insecure = true
+	client, err = config.Client()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if code := testGetRegionCode(t, client); code != "KR" {
+		t.Fatalf("Expected: KR, Actual: %s", code)
+	}
+}
+
+func TestConfig_ClientCABundle(t *testing.T) {
+	ts := httptest.NewTLSServer(testRegionListHandler(t, "/server/v2/getRegionList"))
+	defer ts.Close()
+
+	caBundle := filepath.Join(t.TempDir(), "ca.pem")
+	caPem := pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: ts.Certificate().Raw})
+	if err := ioutil.WriteFile(caBundle, caPem, 0600); err != nil {
+		t.Fatal(err)
+	}
+
+	client, err := (&Config{
+		AccessKey: "access",
+		SecretKey: "secret",
+		Region:    "KR",
+		Endpoints: map[string]string{"server": ts.URL + "/server/v2"},
+		CABundle:  caBundle,
+	}).Client()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if code := testGetRegionCode(t, client); code != "KR" {
+		t.Fatalf("Expected: KR, Actual: %s", code)
+	}
+
+	if _, err := (&Config{CABundle: filepath.Join(t.TempDir(), "none.pem")}).Client(); err == nil {
+		t.Fatal("Expected error for missing ca_bundle")
+	}
+}
+
+func TestConfig_ClientHTTPProxy(t *testing.T) {
+	var proxied string
+	proxy := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		proxied = r.URL.String()
+		w.Write([]byte(`{"getRegionListResponse": {"returnCode":"0","regionList":[{"regionNo":"1","regionCode":"KR"}]}}`))
+	}))
+	defer proxy.Close()
+
+	client, err := (&Config{
+		AccessKey: "access",
+		SecretKey: "secret",
+		Region:    "KR",
+		Endpoints: map[string]string{"server": "http://api.example.com/server/v2"},
+		HTTPProxy: proxy.URL,
+	}).Client()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if code := testGetRegionCode(t, client); code != "KR" {
+		t.Fatalf("Expected: KR, Actual: %s", code)
+	}
+
+	if expected := "http://api.example.com/server/v2/getRegionList"; proxied != expected {
+		t.Fatalf("Expected request through proxy: %s, Actual: %s", expected, proxied)
+	}
+}
+
+func TestProviderConfig_setPlatformServices(t *testing.T) {
+	classic := &ProviderConfig{}
+	classic.setPlatformServices()
+	if _, ok := classic.ServerService.(*classicServerService); !ok {
+		t.Fatalf("Expected: classicServerService, Actual: %T", classic.ServerService)
+	}
+	if _, ok := classic.BlockStorageService.(*classicBlockStorageService); !ok {
+		t.Fatalf("Expected: classicBlockStorageService, Actual: %T", classic.BlockStorageService)
+	}
+	if _, ok := classic.PublicIpService.(*classicPublicIpService); !ok {
+		t.Fatalf("Expected: classicPublicIpService, Actual: %T", classic.PublicIpService)
+	}
+
+	vpc := &ProviderConfig{SupportVPC: true}
+	vpc.setPlatformServices()
+	if _, ok := vpc.ServerService.(*vpcServerService); !ok {
+		t.Fatalf("Expected: vpcServerService, Actual: %T", vpc.ServerService)
+	}
+	if _, ok := vpc.BlockStorageService.(*vpcBlockStorageService); !ok {
+		t.Fatalf("Expected: vpcBlockStorageService, Actual: %T", vpc.BlockStorageService)
+	}
+	if _, ok := vpc.PublicIpService.(*vpcPublicIpService); !ok {
+		t.Fatalf("Expected: vpcPublicIpService, Actual: %T", vpc.PublicIpService)
+	}
+}
@@ -1,7 +1,8 @@
 package ncloud
 
 import (
-	"fmt"
+	"net/http"
+	"net/http/httptest"
 	"reflect"
 	"testing"
 
@@ -47,10 +48,16 @@ func TestGetCommonResponse_convertNil(t *testing.T) {
 }
 
 func TestGetCommonErrorBody(t *testing.T) {
-	err := fmt.Errorf(`Status: 400 Bad Request, Body: {"responseError": {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusBadRequest)
+		w.Write([]byte(`{"responseError": {
   "returnCode": "1007009",
   "returnMessage": "If the Acg settings are being changed, you cannot change other settings at the same time."
-}}`)
+}}`))
+	}))
+	defer ts.Close()
+
+	_, err := (&http.Client{Transport: &apiErrorTransport{transport: http.DefaultTransport}}).Get(ts.URL)
 
 	e, err := GetCommonErrorBody(err)
 
@@ -0,0 +1,178 @@
+package ncloud
+
+import (
+	"bufio"
+	"encoding/json"
+	"fmt"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"runtime"
+	"strings"
+
+	"github.com/NaverCloudPlatform/ncloud-sdk-go-v2/ncloud/credentials"
+)
+
+const (
+	DefaultCredentialsProfile = "DEFAULT"
+
+	credentialsFileAccessKey         = "ncloud_access_key_id"
+	credentialsFileSecretKey         = "ncloud_secret_access_key"
+	credentialsFileCredentialProcess = "credential_process"
+)
+
+// CredentialsConfig settings of the provider used to resolve access key and secret key.
+// The Env fields are read from the environment variables, which apply only when the provider block sets no credentials.
+type CredentialsConfig struct {
+	AccessKey         string
+	SecretKey         string
+	Profile           string
+	CredentialsFile   string
+	CredentialProcess string
+
+	EnvAccessKey string
+	EnvSecretKey string
+	EnvProfile   string
+}
+
+type credentialProcessOutput struct {
+	AccessKey string `json:"ncloud_access_key_id"`
+	SecretKey string `json:"ncloud_secret_access_key"`
+}
+
+// resolveCredentials returns access key and secret key in the following order, so that the credentials set in the provider block
+// are never overridden by the environment variables shared by all provider instances.
+//  1. `access_key` and `secret_key` of the provider
+//  2. `credential_process` of the provider
+//  3. `profile` of the provider in the credentials file, which may set the keys or a `credential_process`
+//  4. NCLOUD_ACCESS_KEY and NCLOUD_SECRET_KEY
+//  5. NCLOUD_PROFILE, or DEFAULT, in the credentials file
+func resolveCredentials(c *CredentialsConfig) (string, string, error) {
+	if c.AccessKey != "" || c.SecretKey != "" {
+		if c.AccessKey == "" || c.SecretKey == "" {
+			return "", "", fmt.Errorf("both `access_key` and `secret_key` must be set")
+		}
+		return c.AccessKey, c.SecretKey, nil
+	}
+
+	if c.CredentialProcess != "" {
+		return runCredentialProcess(c.CredentialProcess)
+	}
+
+	profile := c.Profile
+	if profile == "" {
+		if c.EnvAccessKey != "" || c.EnvSecretKey != "" {
+			if c.EnvAccessKey == "" || c.EnvSecretKey == "" {
+				return "", "", fmt.Errorf("both NCLOUD_ACCESS_KEY and NCLOUD_SECRET_KEY must be set")
+			}
+			return c.EnvAccessKey, c.EnvSecretKey, nil
+		}
+		profile = c.EnvProfile
+	}
+
+	path := c.CredentialsFile
+	if path == "" {
+		path = filepath.Join(credentials.UserHomeDir(), ".ncloud", "configure")
+	}
+
+	explicitProfile := profile != ""
+	if profile == "" {
+		profile = DefaultCredentialsProfile
+	}
+
+	profiles, err := loadCredentialsFile(path)
+	if err != nil {
+		if os.IsNotExist(err) && c.CredentialsFile == "" && !explicitProfile {
+			return "", "", fmt.Errorf("no credentials found. set `access_key` and `secret_key`, `credential_process` or `profile` of the provider")
+		}
+		return "", "", fmt.Errorf("error reading credentials file (%s): %s", path, err)
+	}
+
+	values, ok := profiles[profile]
+	if !ok {
+		return "", "", fmt.Errorf("profile `%s` not found in credentials file (%s)", profile, path)
+	}
+
+	if process := values[credentialsFileCredentialProcess]; process != "" {
+		return runCredentialProcess(process)
+	}
+
+	if values[credentialsFileAccessKey] == "" || values[credentialsFileSecretKey] == "" {
+		return "", "", fmt.Errorf("profile `%s` in credentials file (%s) has no `%s` and `%s`", profile, path, credentialsFileAccessKey, credentialsFileSecretKey)
+	}
+
+	return values[credentialsFileAccessKey], values[credentialsFileSecretKey], nil
+}
+
+// loadCredentialsFile parses the INI formatted credentials file used by ncloud cli.
+// Keys before the first section belong to the DEFAULT profile.
+func loadCredentialsFile(path string) (map[string]map[string]string, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer file.Close()
+
+	profiles := map[string]map[string]string{}
+	profile := DefaultCredentialsProfile
+
+	scanner := bufio.NewScanner(file)
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" || strings.HasPrefix(line, "#") || strings.HasPrefix(line, ";") {
+			continue
+		}
+
+		if strings.HasPrefix(line, "[") && strings.HasSuffix(line, "]") {
+			profile = strings.TrimSpace(strings.TrimPrefix(strings.TrimSuffix(line, "]"), "["))
+			if profiles[profile] == nil {
+				profiles[profile] = map[string]string{}
+			}
+			continue
+		}
+
+		s := strings.SplitN(line, "=", 2)
+		if len(s) != 2 {
+			continue
+		}
+
+		if profiles[profile] == nil {
+			profiles[profile] = map[string]string{}
+		}
+		profiles[profile][strings.TrimSpace(s[0])] = strings.TrimSpace(s[1])
+	}
+
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+
+	return profiles, nil
+}
+
+// runCredentialProcess runs the command and reads the keys from its JSON output, e.g.
+// {"ncloud_access_key_id": "...", "ncloud_secret_access_key": "..."}
+func runCredentialProcess(process string) (string, string, error) {
+	var cmd *exec.Cmd
+	if runtime.GOOS == "windows" {
+		cmd = exec.Command("cmd.exe", "/C", process)
+	} else {
+		cmd = exec.Command("sh", "-c", process)
+	}
+	cmd.Stderr = os.Stderr
+
+	out, err := cmd.Output()
+	if err != nil {
+		return "", "", fmt.Errorf("error running credential_process: %s", err)
+	}
+
+	output := &credentialProcessOutput{}
+	if err := json.Unmarshal(out, output); err != nil {
+		return "", "", fmt.Errorf("error parsing output of credential_process: %s", err)
+	}
+
+	if output.AccessKey == "" || output.SecretKey == "" {
+		return "", "", fmt.Errorf("output of credential_process has no `%s` and `%s`", credentialsFileAccessKey, credentialsFileSecretKey)
+	}
+
+	return output.AccessKey, output.SecretKey, nil
+}
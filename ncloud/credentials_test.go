@@ -0,0 +1,164 @@
+package ncloud
+
+import (
+	"io/ioutil"
+	"path/filepath"
+	"runtime"
+	"testing"
+)
+
+func testCredentialsFile(t *testing.T, content string) string {
+	path := filepath.Join(t.TempDir(), "configure")
+	if err := ioutil.WriteFile(path, []byte(content), 0600); err != nil {
+		t.Fatal(err)
+	}
+	return path
+}
+
+func TestResolveCredentials_static(t *testing.T) {
+	accessKey, secretKey, err := resolveCredentials(&CredentialsConfig{
+		AccessKey:         "static-access",
+		SecretKey:         "static-secret",
+		CredentialProcess: "exit 1",
+	})
+	if err != nil {
+		t.Fatalf("Unexpected error: %s", err)
+	}
+
+	if accessKey != "static-access" || secretKey != "static-secret" {
+		t.Fatalf("Expected static keys, Actual: %s, %s", accessKey, secretKey)
+	}
+}
+
+func TestResolveCredentials_partialStatic(t *testing.T) {
+	if _, _, err := resolveCredentials(&CredentialsConfig{AccessKey: "static-access"}); err == nil {
+		t.Fatal("Expected error for missing secret_key")
+	}
+}
+
+func TestResolveCredentials_profile(t *testing.T) {
+	path := testCredentialsFile(t, `
+ncloud_access_key_id = default-access
+ncloud_secret_access_key = default-secret
+
+# sub account
+[dev]
+ncloud_access_key_id = dev-access
+ncloud_secret_access_key = dev-secret
+`)
+
+	cases := map[string][]string{
+		"":        {"default-access", "default-secret"},
+		"DEFAULT": {"default-access", "default-secret"},
+		"dev":     {"dev-access", "dev-secret"},
+	}
+
+	for profile, expected := range cases {
+		accessKey, secretKey, err := resolveCredentials(&CredentialsConfig{Profile: profile, CredentialsFile: path})
+		if err != nil {
+			t.Fatalf("Unexpected error for profile %q: %s", profile, err)
+		}
+
+		if accessKey != expected[0] || secretKey != expected[1] {
+			t.Fatalf("Expected for profile %q: %v, Actual: %s, %s", profile, expected, accessKey, secretKey)
+		}
+	}
+
+	if _, _, err := resolveCredentials(&CredentialsConfig{Profile: "prod", CredentialsFile: path}); err == nil {
+		t.Fatal("Expected error for unknown profile")
+	}
+}
+
+func TestResolveCredentials_credentialProcess(t *testing.T) {
+	if runtime.GOOS == "windows" {
+		t.Skip("credential_process test uses sh")
+	}
+
+	accessKey, secretKey, err := resolveCredentials(&CredentialsConfig{
+		CredentialProcess: `echo '{"ncloud_access_key_id": "process-access", "ncloud_secret_access_key": "process-secret"}'`,
+	})
+	if err != nil {
+		t.Fatalf("Unexpected error: %s", err)
+	}
+
+	if accessKey != "process-access" || secretKey != "process-secret" {
+		t.Fatalf("Expected process keys, Actual: %s, %s", accessKey, secretKey)
+	}
+
+	if _, _, err := resolveCredentials(&CredentialsConfig{CredentialProcess: "echo '{}'"}); err == nil {
+		t.Fatal("Expected error for output without keys")
+	}
+
+	if _, _, err := resolveCredentials(&CredentialsConfig{CredentialProcess: "exit 1"}); err == nil {
+		t.Fatal("Expected error for failed command")
+	}
+}
+
+func TestResolveCredentials_profileCredentialProcess(t *testing.T) {
+	if runtime.GOOS == "windows" {
+		t.Skip("credential_process test uses sh")
+	}
+
+	path := testCredentialsFile(t, `
+[vault]
+credential_process = echo '{"ncloud_access_key_id": "vault-access", "ncloud_secret_access_key": "vault-secret"}'
+`)
+
+	accessKey, secretKey, err := resolveCredentials(&CredentialsConfig{Profile: "vault", CredentialsFile: path})
+	if err != nil {
+		t.Fatalf("Unexpected error: %s", err)
+	}
+
+	if accessKey != "vault-access" || secretKey != "vault-secret" {
+		t.Fatalf("Expected vault keys, Actual: %s, %s", accessKey, secretKey)
+	}
+}
+
+func TestResolveCredentials_environment(t *testing.T) {
+	path := testCredentialsFile(t, `
+[DEFAULT]
+ncloud_access_key_id = default-access
+ncloud_secret_access_key = default-secret
+
+[dev]
+ncloud_access_key_id = dev-access
+ncloud_secret_access_key = dev-secret
+`)
+
+	cases := map[string]struct {
+		config   *CredentialsConfig
+		expected []string
+	}{
+		"static beats environment": {
+			config:   &CredentialsConfig{AccessKey: "static-access", SecretKey: "static-secret", EnvAccessKey: "env-access", EnvSecretKey: "env-secret"},
+			expected: []string{"static-access", "static-secret"},
+		},
+		"profile beats environment keys": {
+			config:   &CredentialsConfig{Profile: "dev", CredentialsFile: path, EnvAccessKey: "env-access", EnvSecretKey: "env-secret"},
+			expected: []string{"dev-access", "dev-secret"},
+		},
+		"environment keys beat environment profile": {
+			config:   &CredentialsConfig{CredentialsFile: path, EnvAccessKey: "env-access", EnvSecretKey: "env-secret", EnvProfile: "dev"},
+			expected: []string{"env-access", "env-secret"},
+		},
+		"environment profile": {
+			config:   &CredentialsConfig{CredentialsFile: path, EnvProfile: "dev"},
+			expected: []string{"dev-access", "dev-secret"},
+		},
+	}
+
+	for name, tc := range cases {
+		accessKey, secretKey, err := resolveCredentials(tc.config)
+		if err != nil {
+			t.Fatalf("Unexpected error for %s: %s", name, err)
+		}
+
+		if accessKey != tc.expected[0] || secretKey != tc.expected[1] {
+			t.Fatalf("Expected for %s: %v, Actual: %s, %s", name, tc.expected, accessKey, secretKey)
+		}
+	}
+
+	if _, _, err := resolveCredentials(&CredentialsConfig{EnvAccessKey: "env-access"}); err == nil {
+		t.Fatal("Expected error for missing NCLOUD_SECRET_KEY")
+	}
+}
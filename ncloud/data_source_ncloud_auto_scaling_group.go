@@ -104,8 +104,13 @@ func getVpcAutoScalingGroupList(config *ProviderConfig, id string) ([]*AutoScali
 
 func getClassicAutoScalingGroupList(config *ProviderConfig, id string) ([]*AutoScalingGroup, error) {
 	no := ncloud.String(id)
+	regionNo, err := config.regionNo()
+	if err != nil {
+		return nil, err
+	}
+
 	reqParams := &autoscaling.GetAutoScalingGroupListRequest{
-		RegionNo: &config.RegionNo,
+		RegionNo: regionNo,
 	}
 
 	resp, err := config.Client.autoscaling.V2Api.GetAutoScalingGroupList(reqParams)
@@ -66,7 +66,7 @@ func getBlockStorageList(d *schema.ResourceData, config *ProviderConfig) ([]*Blo
 }
 
 func getClassicBlockStorageList(d *schema.ResourceData, config *ProviderConfig) ([]*BlockStorage, error) {
-	regionNo, err := parseRegionNoParameter(d)
+	regionNo, err := parseRegionNoParameter(config, d)
 	if err != nil {
 		return nil, err
 	}
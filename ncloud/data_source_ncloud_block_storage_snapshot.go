@@ -83,7 +83,7 @@ func getBlockStorageSnapshot(d *schema.ResourceData, config *ProviderConfig) ([]
 }
 
 func getClassicBlockStorageSnapshot(d *schema.ResourceData, config *ProviderConfig) ([]*BlockStorageSnapshot, error) {
-	regionNo, err := parseRegionNoParameter(d)
+	regionNo, err := parseRegionNoParameter(config, d)
 	if err != nil {
 		return nil, err
 	}
@@ -101,8 +101,13 @@ func getVpcLaunchConfigurationList(config *ProviderConfig, id string) ([]*Launch
 
 func getClassicLaunchConfigurationList(config *ProviderConfig, id string) ([]*LaunchConfiguration, error) {
 	no := ncloud.String(id)
+	regionNo, err := config.regionNo()
+	if err != nil {
+		return nil, err
+	}
+
 	reqParams := &autoscaling.GetLaunchConfigurationListRequest{
-		RegionNo: &config.RegionNo,
+		RegionNo: regionNo,
 	}
 	logCommonRequest("getClassicLaunchConfigurationList", reqParams)
 	resp, err := config.Client.autoscaling.V2Api.GetLaunchConfigurationList(reqParams)
@@ -40,7 +40,7 @@ func dataSourceNcloudLbRead(ctx context.Context, d *schema.ResourceData, meta in
 
 	lbList, err := getVpcLoadBalancerList(config, d.Id())
 	if err != nil {
-		return diag.FromErr(err)
+		return ToDiagnostics(err)
 	}
 
 	lbListMap := ConvertToArrayMap(lbList)
@@ -49,7 +49,7 @@ func dataSourceNcloudLbRead(ctx context.Context, d *schema.ResourceData, meta in
 	}
 
 	if err := validateOneResult(len(lbListMap)); err != nil {
-		return diag.FromErr(err)
+		return ToDiagnostics(err)
 	}
 
 	d.SetId(lbListMap[0]["load_balancer_no"].(string))
@@ -49,7 +49,7 @@ func dataSourceNcloudLbListenerRead(ctx context.Context, d *schema.ResourceData,
 	listenerList, err := getVpcLoadBalancerListenerList(config, d.Id(), d.Get("load_balancer_no").(string))
 
 	if err != nil {
-		return diag.FromErr(err)
+		return ToDiagnostics(err)
 	}
 
 	listenerListMap := ConvertToArrayMap(listenerList)
@@ -58,7 +58,7 @@ func dataSourceNcloudLbListenerRead(ctx context.Context, d *schema.ResourceData,
 	}
 
 	if err := validateOneResult(len(listenerListMap)); err != nil {
-		return diag.FromErr(err)
+		return ToDiagnostics(err)
 	}
 
 	d.SetId(listenerListMap[0]["listener_no"].(string))
@@ -76,7 +76,7 @@ func dataSourceNcloudLbTargetGroupRead(ctx context.Context, d *schema.ResourceDa
 
 	targetGroupList, err := getVpcLoadBalancerTargetGroupList(config, d.Id())
 	if err != nil {
-		return diag.FromErr(err)
+		return ToDiagnostics(err)
 	}
 
 	targetGroupListMap := ConvertToArrayMap(targetGroupList)
@@ -85,7 +85,7 @@ func dataSourceNcloudLbTargetGroupRead(ctx context.Context, d *schema.ResourceDa
 	}
 
 	if err := validateOneResult(len(targetGroupListMap)); err != nil {
-		return diag.FromErr(err)
+		return ToDiagnostics(err)
 	}
 
 	d.SetId(targetGroupListMap[0]["target_group_no"].(string))
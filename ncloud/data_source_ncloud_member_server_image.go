@@ -142,10 +142,13 @@ func dataSourceNcloudMemberServerImageRead(d *schema.ResourceData, meta interfac
 
 func getClassicMemberServerImage(d *schema.ResourceData, config *ProviderConfig) ([]map[string]interface{}, error) {
 	client := config.Client
-	regionNo := config.RegionNo
+	regionNo, err := config.regionNo()
+	if err != nil {
+		return nil, err
+	}
 
 	reqParams := &server.GetMemberServerImageListRequest{
-		RegionNo: &regionNo,
+		RegionNo: regionNo,
 	}
 
 	if noList, ok := d.GetOk("no_list"); ok {
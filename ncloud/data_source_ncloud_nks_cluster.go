@@ -97,7 +97,7 @@ func dataSourceNcloudNKSClusterRead(ctx context.Context, d *schema.ResourceData,
 	uuid := d.Get("uuid").(string)
 	cluster, err := getNKSCluster(ctx, config, uuid)
 	if err != nil {
-		return diag.FromErr(err)
+		return ToDiagnostics(err)
 	}
 
 	if cluster == nil {
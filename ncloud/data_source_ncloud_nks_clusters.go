@@ -31,7 +31,7 @@ func dataSourceNcloudNKSClustersRead(ctx context.Context, d *schema.ResourceData
 
 	clusters, err := getNKSClusters(ctx, config)
 	if err != nil {
-		return diag.FromErr(err)
+		return ToDiagnostics(err)
 	}
 
 	var cUuids []*string
@@ -3,12 +3,18 @@ package ncloud
 import (
 	"context"
 	"fmt"
+
 	"github.com/NaverCloudPlatform/ncloud-sdk-go-v2/ncloud"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"gopkg.in/yaml.v3"
 )
 
+const (
+	NKSKubeConfigExecAPIVersion = "client.authentication.k8s.io/v1beta1"
+	NKSKubeConfigExecCommand    = "ncp-iam-authenticator"
+)
+
 func init() {
 	RegisterDataSource("ncloud_nks_kube_config", dataSourceNcloudNKSKubeConfig())
 }
@@ -26,14 +32,30 @@ func dataSourceNcloudNKSKubeConfig() *schema.Resource {
 				Computed: true,
 			},
 			"client_certificate": {
-				Type:     schema.TypeString,
-				Computed: true,
+				Type:      schema.TypeString,
+				Computed:  true,
+				Sensitive: true,
 			},
 			"client_key": {
+				Type:      schema.TypeString,
+				Computed:  true,
+				Sensitive: true,
+			},
+			"cluster_ca_certificate": {
 				Type:     schema.TypeString,
 				Computed: true,
 			},
-			"cluster_ca_certificate": {
+			"exec_command": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Default:  NKSKubeConfigExecCommand,
+			},
+			"kube_config_raw": {
+				Type:      schema.TypeString,
+				Computed:  true,
+				Sensitive: true,
+			},
+			"kube_config_exec": {
 				Type:     schema.TypeString,
 				Computed: true,
 			},
@@ -48,16 +70,26 @@ func dataSourceNcloudNKSKubeConfigRead(ctx context.Context, d *schema.ResourceDa
 	}
 	clusterUuid := d.Get("cluster_uuid").(string)
 
-	kubeConfig, err := getNKSKubeConfig(ctx, config, clusterUuid)
+	raw, err := getNKSKubeConfigRaw(ctx, config, clusterUuid)
 	if err != nil {
-		return diag.FromErr(err)
+		return ToDiagnostics(err)
 	}
 
-	if kubeConfig == nil {
+	kubeConfig, err := parseNKSKubeConfig(raw)
+	if err != nil {
+		return ToDiagnostics(err)
+	}
+
+	if kubeConfig == nil || len(kubeConfig.Clusters) == 0 {
 		d.SetId("")
 		return nil
 	}
 
+	execKubeConfig, err := buildNKSExecKubeConfig(kubeConfig, clusterUuid, config.RegionCode, d.Get("exec_command").(string))
+	if err != nil {
+		return ToDiagnostics(err)
+	}
+
 	d.SetId(clusterUuid)
 	d.Set("host", kubeConfig.Clusters[0].Cluster.Server)
 	d.Set("cluster_ca_certificate", kubeConfig.Clusters[0].Cluster.ClusterCaCertificate)
@@ -67,21 +99,81 @@ func dataSourceNcloudNKSKubeConfigRead(ctx context.Context, d *schema.ResourceDa
 		d.Set("client_key", kubeConfig.Users[0].User.ClientKeyData)
 	}
 
+	d.Set("kube_config_raw", raw)
+	d.Set("kube_config_exec", execKubeConfig)
+
 	return nil
 }
 
-func getNKSKubeConfig(ctx context.Context, config *ProviderConfig, uuid string) (kc *KubeConfig, err error) {
-	resp, err := config.Client.vnks.V2Api.ClustersUuidKubeconfigGet(ctx, ncloud.String(uuid))
+func getNKSKubeConfig(ctx context.Context, config *ProviderConfig, uuid string) (*KubeConfig, error) {
+	raw, err := getNKSKubeConfigRaw(ctx, config, uuid)
 	if err != nil {
 		return nil, err
 	}
-	err = yaml.Unmarshal([]byte(ncloud.StringValue(resp.Kubeconfig)), &kc)
+	return parseNKSKubeConfig(raw)
+}
+
+func getNKSKubeConfigRaw(ctx context.Context, config *ProviderConfig, uuid string) (string, error) {
+	resp, err := config.Client.vnks.V2Api.ClustersUuidKubeconfigGet(ctx, ncloud.String(uuid))
 	if err != nil {
-		fmt.Printf("%s", err)
+		return "", err
+	}
+	return ncloud.StringValue(resp.Kubeconfig), nil
+}
+
+func parseNKSKubeConfig(raw string) (kc *KubeConfig, err error) {
+	if err := yaml.Unmarshal([]byte(raw), &kc); err != nil {
+		return nil, fmt.Errorf("error parsing kubeconfig: %s", err)
 	}
 	return kc, nil
 }
 
+// buildNKSExecKubeConfig returns kubeconfig which gets the token from exec credential plugin instead of the client certificate
+func buildNKSExecKubeConfig(kc *KubeConfig, clusterUuid string, region string, command string) (string, error) {
+	name := fmt.Sprintf("nks_%s_%s", region, clusterUuid)
+	execConfig := map[string]interface{}{
+		"apiVersion":      "v1",
+		"kind":            "Config",
+		"current-context": name,
+		"clusters": []map[string]interface{}{
+			{
+				"name": name,
+				"cluster": map[string]string{
+					"server":                     kc.Clusters[0].Cluster.Server,
+					"certificate-authority-data": kc.Clusters[0].Cluster.ClusterCaCertificate,
+				},
+			},
+		},
+		"contexts": []map[string]interface{}{
+			{
+				"name": name,
+				"context": map[string]string{
+					"cluster": name,
+					"user":    name,
+				},
+			},
+		},
+		"users": []map[string]interface{}{
+			{
+				"name": name,
+				"user": map[string]interface{}{
+					"exec": map[string]interface{}{
+						"apiVersion": NKSKubeConfigExecAPIVersion,
+						"command":    command,
+						"args":       []string{"token", "--clusterUuid", clusterUuid, "--region", region},
+					},
+				},
+			},
+		},
+	}
+
+	b, err := yaml.Marshal(execConfig)
+	if err != nil {
+		return "", err
+	}
+	return string(b), nil
+}
+
 type KubeConfig struct {
 	Clusters []struct {
 		Cluster struct {
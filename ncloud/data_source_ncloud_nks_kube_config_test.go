@@ -2,6 +2,8 @@ package ncloud
 
 import (
 	"fmt"
+	"regexp"
+	"strings"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
@@ -24,12 +26,49 @@ func TestAccDataSourceNcloudNKSKubeConfig(t *testing.T) {
 					testAccCheckDataSourceID(dataName),
 					resource.TestCheckResourceAttrPair(dataName, "cluster_uuid", resourceName, "uuid"),
 					resource.TestCheckResourceAttrPair(dataName, "host", resourceName, "endpoint"),
+					resource.TestMatchResourceAttr(dataName, "kube_config_raw", regexp.MustCompile("client-key-data")),
+					resource.TestMatchResourceAttr(dataName, "kube_config_exec", regexp.MustCompile(NKSKubeConfigExecCommand)),
 				),
 			},
 		},
 	})
 }
 
+func TestBuildNKSExecKubeConfig(t *testing.T) {
+	kc, err := parseNKSKubeConfig(`
+apiVersion: v1
+kind: Config
+clusters:
+- name: cluster
+  cluster:
+    server: https://uuid.kr.vnks.ntruss.com
+    certificate-authority-data: Q0E=
+users:
+- name: user
+  user:
+    client-certificate-data: Q0VSVA==
+    client-key-data: S0VZ
+`)
+	if err != nil {
+		t.Fatalf("Unexpected error: %s", err)
+	}
+
+	result, err := buildNKSExecKubeConfig(kc, "uuid", "KR", NKSKubeConfigExecCommand)
+	if err != nil {
+		t.Fatalf("Unexpected error: %s", err)
+	}
+
+	for _, expected := range []string{"server: https://uuid.kr.vnks.ntruss.com", "certificate-authority-data: Q0E=", "command: ncp-iam-authenticator", "- --clusterUuid", "- uuid", "- KR"} {
+		if !strings.Contains(result, expected) {
+			t.Fatalf("Expected kubeconfig contains %q, Actual: %s", expected, result)
+		}
+	}
+
+	if strings.Contains(result, "client-key-data") {
+		t.Fatalf("Expected kubeconfig without client key, Actual: %s", result)
+	}
+}
+
 func testAccDataSourceNKSKubeConfigConfig(testClusterName string, clusterType string, loginKey string, version string, region string) string {
 	return fmt.Sprintf(`
 resource "ncloud_vpc" "vpc" {
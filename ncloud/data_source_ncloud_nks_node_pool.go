@@ -65,6 +65,42 @@ func dataSourceNcloudNKSNodePool() *schema.Resource {
 					},
 				},
 			},
+			"label": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"key": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"value": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+			"taint": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"key": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"value": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"effect": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
 		},
 	}
 }
@@ -81,7 +117,7 @@ func dataSourceNcloudNKSNodePoolRead(ctx context.Context, d *schema.ResourceData
 
 	nodePool, err := getNKSNodePool(ctx, config, clusterUuid, nodePoolName)
 	if err != nil {
-		return diag.FromErr(err)
+		return ToDiagnostics(err)
 	}
 
 	if nodePool == nil {
@@ -102,5 +138,13 @@ func dataSourceNcloudNKSNodePoolRead(ctx context.Context, d *schema.ResourceData
 	if err := d.Set("autoscale", flattenNKSNodePoolAutoScale(nodePool.Autoscale)); err != nil {
 		log.Printf("[WARN] Error setting Autoscale set for (%s): %s", d.Id(), err)
 	}
+
+	if err := d.Set("label", flattenNKSNodePoolLabels(nodePool.Labels)); err != nil {
+		log.Printf("[WARN] Error setting label set for (%s): %s", d.Id(), err)
+	}
+
+	if err := d.Set("taint", flattenNKSNodePoolTaints(nodePool.Taints)); err != nil {
+		log.Printf("[WARN] Error setting taint set for (%s): %s", d.Id(), err)
+	}
 	return nil
 }
@@ -37,7 +37,7 @@ func dataSourceNcloudNKSNodePoolsRead(ctx context.Context, d *schema.ResourceDat
 
 	nodePools, err := getNKSNodePools(ctx, config, clusterUuid)
 	if err != nil {
-		return diag.FromErr(err)
+		return ToDiagnostics(err)
 	}
 
 	var npNames []*string
@@ -0,0 +1,172 @@
+package ncloud
+
+import (
+	"context"
+
+	"github.com/NaverCloudPlatform/ncloud-sdk-go-v2/ncloud"
+	"github.com/NaverCloudPlatform/ncloud-sdk-go-v2/services/vnks"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func init() {
+	RegisterDataSource("ncloud_nks_nodes", dataSourceNcloudNKSNodes())
+}
+
+func dataSourceNcloudNKSNodes() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceNcloudNKSNodesRead,
+		Schema: map[string]*schema.Schema{
+			"cluster_uuid": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"node_pool_name": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"filter": dataSourceFiltersSchema(),
+			"nodes": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"instance_no": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"node_pool_name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"private_ip": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"public_ip": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"status": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"server_status": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"k8s_status": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"server_product_code": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"zone": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"vpc_no": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"subnet_no": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceNcloudNKSNodesRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*ProviderConfig)
+	if !config.SupportVPC {
+		return diag.FromErr(NotSupportClassic("dataSource `ncloud_nks_nodes`"))
+	}
+
+	clusterUuid := d.Get("cluster_uuid").(string)
+	resources, err := getNKSNodeList(ctx, config, clusterUuid, d.Get("node_pool_name").(string))
+	if err != nil {
+		return ToDiagnostics(err)
+	}
+
+	if f, ok := d.GetOk("filter"); ok {
+		resources = ApplyFilters(f.(*schema.Set), resources, dataSourceNcloudNKSNodes().Schema["nodes"].Elem.(*schema.Resource).Schema)
+	}
+
+	d.SetId(clusterUuid)
+	if err := d.Set("nodes", resources); err != nil {
+		return diag.Errorf("Error setting nodes: %s", err)
+	}
+
+	return nil
+}
+
+// getNKSNodeList returns worker nodes of the cluster joined with their server instances
+func getNKSNodeList(ctx context.Context, config *ProviderConfig, clusterUuid string, nodePoolName string) ([]map[string]interface{}, error) {
+	logCommonRequest("getNKSNodeList", clusterUuid)
+	resp, err := config.Client.vnks.V2Api.ClustersUuidNodesGet(ctx, ncloud.String(clusterUuid))
+	if err != nil {
+		logErrorResponse("getNKSNodeList", err, clusterUuid)
+		return nil, err
+	}
+
+	logResponse("getNKSNodeList", resp)
+
+	nodes := make([]*vnks.WorkerNode, 0, len(resp.Nodes))
+	instanceNoList := make([]string, 0, len(resp.Nodes))
+	for _, node := range resp.Nodes {
+		if nodePoolName != "" && ncloud.StringValue(node.NodePoolName) != nodePoolName {
+			continue
+		}
+		nodes = append(nodes, node)
+		instanceNoList = append(instanceNoList, ncloud.StringValue(node.Id))
+	}
+
+	// Servers of all the nodes are read in one list call
+	instances, err := config.readServerList(instanceNoList)
+	if err != nil {
+		return nil, err
+	}
+
+	resources := []map[string]interface{}{}
+	for _, node := range nodes {
+		instanceNo := ncloud.StringValue(node.Id)
+		mapping := map[string]interface{}{
+			"instance_no":         instanceNo,
+			"name":                ncloud.StringValue(node.Name),
+			"node_pool_name":      ncloud.StringValue(node.NodePoolName),
+			"private_ip":          ncloud.StringValue(node.PrivateIp),
+			"public_ip":           ncloud.StringValue(node.PublicIp),
+			"status":              ncloud.StringValue(node.StatusCode),
+			"k8s_status":          ncloud.StringValue(node.K8sStatus),
+			"server_product_code": ncloud.StringValue(node.SpecCode),
+		}
+
+		// Attributes of the node are kept, the server instance only adds the ones NKS doesn't return
+		if instance := instances[instanceNo]; instance != nil {
+			if node.PrivateIp == nil {
+				mapping["private_ip"] = ncloud.StringValue(instance.PrivateIp)
+			}
+			if node.SpecCode == nil {
+				mapping["server_product_code"] = ncloud.StringValue(instance.ServerProductCode)
+			}
+			mapping["server_status"] = ncloud.StringValue(instance.ServerInstanceStatus)
+			mapping["zone"] = ncloud.StringValue(instance.Zone)
+			mapping["vpc_no"] = ncloud.StringValue(instance.VpcNo)
+			mapping["subnet_no"] = ncloud.StringValue(instance.SubnetNo)
+		}
+
+		resources = append(resources, mapping)
+	}
+
+	return resources, nil
+}
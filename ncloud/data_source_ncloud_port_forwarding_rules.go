@@ -93,7 +93,7 @@ func dataSourceNcloudPortForwardingRulesRead(d *schema.ResourceData, meta interf
 		return NotSupportVpc("data source `ncloud_port_forwarding_rules`")
 	}
 
-	regionNo, err := parseRegionNoParameter(d)
+	regionNo, err := parseRegionNoParameter(config, d)
 	if err != nil {
 		return err
 	}
@@ -142,10 +142,13 @@ func dataSourceNcloudPublicIpRead(d *schema.ResourceData, meta interface{}) erro
 
 func getClassicPublicIpList(d *schema.ResourceData, config *ProviderConfig) ([]map[string]interface{}, error) {
 	client := config.Client
-	regionNo := config.RegionNo
+	regionNo, err := config.regionNo()
+	if err != nil {
+		return nil, err
+	}
 
 	reqParams := &server.GetPublicIpInstanceListRequest{
-		RegionNo: &regionNo,
+		RegionNo: regionNo,
 		ZoneNo:   StringPtrOrNil(d.GetOk("zone")),
 	}
 
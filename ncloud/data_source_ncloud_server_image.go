@@ -120,11 +120,14 @@ func getServerImageProductListFiltered(d *schema.ResourceData, config *ProviderC
 
 func getClassicServerImageProductList(d *schema.ResourceData, config *ProviderConfig) ([]map[string]interface{}, error) {
 	client := config.Client
-	regionNo := config.RegionNo
+	regionNo, err := config.regionNo()
+	if err != nil {
+		return nil, err
+	}
 
 	reqParams := &server.GetServerImageProductListRequest{
 		ProductCode:                 StringPtrOrNil(d.GetOk("product_code")),
-		RegionNo:                    &regionNo,
+		RegionNo:                    regionNo,
 		InfraResourceDetailTypeCode: StringPtrOrNil(d.GetOk("infra_resource_detail_type_code")),
 	}
 
@@ -132,7 +132,10 @@ func getServerProductListFiltered(d *schema.ResourceData, config *ProviderConfig
 
 func getClassicServerProductList(d *schema.ResourceData, config *ProviderConfig) ([]map[string]interface{}, error) {
 	client := config.Client
-	regionNo := config.RegionNo
+	regionNo, err := config.regionNo()
+	if err != nil {
+		return nil, err
+	}
 
 	zoneNo, err := parseZoneNoParameter(config, d)
 	if err != nil {
@@ -142,7 +145,7 @@ func getClassicServerProductList(d *schema.ResourceData, config *ProviderConfig)
 		ExclusionProductCode:   StringPtrOrNil(d.GetOk("exclusion_product_code")),
 		ServerImageProductCode: ncloud.String(d.Get("server_image_product_code").(string)),
 		ProductCode:            StringPtrOrNil(d.GetOk("product_code")),
-		RegionNo:               &regionNo,
+		RegionNo:               regionNo,
 		ZoneNo:                 zoneNo,
 	}
 
@@ -47,7 +47,7 @@ func dataSourceNcloudSourceBuildComputesRead(ctx context.Context, d *schema.Reso
 	resp, err := config.Client.sourcebuild.V1Api.GetComputeEnv(ctx)
 	if err != nil {
 		logErrorResponse("GetComputeEnv", err, "")
-		return diag.FromErr(err)
+		return ToDiagnostics(err)
 	}
 	logResponse("GetComputeEnv", resp)
 
@@ -43,7 +43,7 @@ func dataSourceNcloudSourceBuildDockerEnginesRead(ctx context.Context, d *schema
 	resp, err := config.Client.sourcebuild.V1Api.GetDockerEnv(context.Background())
 	if err != nil {
 		logErrorResponse("GetDockerEnv", err, "")
-		return diag.FromErr(err)
+		return ToDiagnostics(err)
 	}
 	logResponse("GetDockerEnv", resp)
 
@@ -51,7 +51,7 @@ func dataSourceNcloudSourceBuildOsRead(ctx context.Context, d *schema.ResourceDa
 	resp, err := config.Client.sourcebuild.V1Api.GetOsEnv(ctx)
 	if err != nil {
 		logErrorResponse("GetOsEnv", err, "")
-		return diag.FromErr(err)
+		return ToDiagnostics(err)
 	}
 	logResponse("GetOsEnv", resp)
 
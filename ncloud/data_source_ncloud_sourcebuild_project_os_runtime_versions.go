@@ -57,7 +57,7 @@ func dataSourceNcloudSourceBuildRuntimeVersionsRead(ctx context.Context, d *sche
 	resp, err := config.Client.sourcebuild.V1Api.GetRuntimeVersionEnv(ctx, osId, runtimeId)
 	if err != nil {
 		logErrorResponse("GetRuntimeVersionEnv", err, "")
-		return diag.FromErr(err)
+		return ToDiagnostics(err)
 	}
 	logResponse("GetRuntimeVersionEnv", resp)
 
@@ -51,7 +51,7 @@ func dataSourceNcloudSourceBuildRuntimesRead(ctx context.Context, d *schema.Reso
 	resp, err := config.Client.sourcebuild.V1Api.GetRuntimeEnv(context.Background(), osId)
 	if err != nil {
 		logErrorResponse("GetRuntimeEnv", err, "")
-		return diag.FromErr(err)
+		return ToDiagnostics(err)
 	}
 	logResponse("GetRuntimeEnv", resp)
 
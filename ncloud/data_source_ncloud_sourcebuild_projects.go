@@ -63,7 +63,7 @@ func dataSourceNcloudSourceBuildProjectsRead(ctx context.Context, d *schema.Reso
 	resp, err := config.Client.sourcebuild.V1Api.GetProjects(ctx, reqParams)
 	if err != nil {
 		logErrorResponse("GetSourceBuildProjects", err, reqParams)
-		return diag.FromErr(err)
+		return ToDiagnostics(err)
 	}
 	logResponse("GetSourceBuildProjects", resp)
 
@@ -60,7 +60,7 @@ func dataSousrceNcloudSourceCommitRepositoriesRead(ctx context.Context, d *schem
 	resp, err := getRepositories(ctx, config)
 	if err != nil {
 		logErrorResponse("GetSourceCommitRepositories", err, "")
-		return diag.FromErr(err)
+		return ToDiagnostics(err)
 	}
 	logResponse("GetSourceCommitRepositories", resp)
 
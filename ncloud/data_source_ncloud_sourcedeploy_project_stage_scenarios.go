@@ -57,7 +57,7 @@ func dataSourceNcloudSourceDeployScenariosReadContext(ctx context.Context, d *sc
 	stageId := ncloud.IntString(d.Get("stage_id").(int))
 	resp, err := GetScenarios(ctx, config, projectId, stageId)
 	if err != nil {
-		return diag.FromErr(err)
+		return ToDiagnostics(err)
 	}
 	logResponse("GetScenarios", resp)
 
@@ -52,7 +52,7 @@ func dataSourceNcloudSourceDeployStagesReadContext(ctx context.Context, d *schem
 	projectId := ncloud.IntString(d.Get("project_id").(int))
 	resp, err := getStages(ctx, config, projectId)
 	if err != nil {
-		return diag.FromErr(err)
+		return ToDiagnostics(err)
 	}
 
 	resources := []map[string]interface{}{}
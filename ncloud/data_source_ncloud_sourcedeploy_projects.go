@@ -49,7 +49,7 @@ func dataSourceNcloudSourceDeployProjectsReadContext(ctx context.Context, d *sch
 	resp, err := config.Client.vsourcedeploy.V1Api.GetProjects(ctx, reqParams)
 
 	if err != nil {
-		return diag.FromErr(err)
+		return ToDiagnostics(err)
 	}
 	logResponse("GetProjects", resp)
 
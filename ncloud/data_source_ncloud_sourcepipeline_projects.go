@@ -46,7 +46,7 @@ func dataSourceNcloudSourcePipelineProjectsRead(ctx context.Context, d *schema.R
 	projects, err := getSourcePipelineProjects(ctx, config)
 	if err != nil {
 		logErrorResponse("getSourcePipelineProjects", err, projects)
-		return diag.FromErr(err)
+		return ToDiagnostics(err)
 	}
 	logResponse("getSourcePipelineProjects", projects)
 
@@ -40,15 +40,7 @@ func dataSourceNcloudZones() *schema.Resource {
 func dataSourceNcloudZonesRead(d *schema.ResourceData, meta interface{}) error {
 	d.SetId(time.Now().UTC().String())
 
-	var zones []*Zone
-	var err error
-
-	if meta.(*ProviderConfig).SupportVPC == true {
-		zones, err = getVpcZones(meta.(*ProviderConfig))
-	} else {
-		zones, err = getClassicZones(meta.(*ProviderConfig))
-	}
-
+	zones, err := meta.(*ProviderConfig).regionCache.getZones()
 	if err != nil {
 		return err
 	}
@@ -71,10 +63,7 @@ func dataSourceNcloudZonesRead(d *schema.ResourceData, meta interface{}) error {
 	return nil
 }
 
-func getClassicZones(config *ProviderConfig) ([]*Zone, error) {
-	client := config.Client
-	regionNo := config.RegionNo
-
+func getClassicZoneList(client *NcloudAPIClient, regionNo string) ([]*Zone, error) {
 	resp, err := client.server.V2Api.GetZoneList(&server.GetZoneListRequest{RegionNo: &regionNo})
 	if err != nil {
 		return nil, err
@@ -93,10 +82,7 @@ func getClassicZones(config *ProviderConfig) ([]*Zone, error) {
 	return zones, nil
 }
 
-func getVpcZones(config *ProviderConfig) ([]*Zone, error) {
-	client := config.Client
-	regionCode := config.RegionCode
-
+func getVpcZoneList(client *NcloudAPIClient, regionCode string) ([]*Zone, error) {
 	resp, err := client.vserver.V2Api.GetZoneList(&vserver.GetZoneListRequest{RegionCode: &regionCode})
 	if err != nil {
 		return nil, err
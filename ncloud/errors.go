@@ -1,6 +1,14 @@
 package ncloud
 
-import "fmt"
+import (
+	"errors"
+	"fmt"
+	"net/url"
+	"strings"
+
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+)
 
 // NotSupportClassic return error for not support classic
 func NotSupportClassic(name string) error {
@@ -14,10 +22,72 @@ func NotSupportVpc(name string) error {
 
 // ErrorRequiredArgOnVpc return error for required on vpc
 func ErrorRequiredArgOnVpc(name string) error {
-	return fmt.Errorf("missing required argument: The argument \"%s\" is required on vpc", name)
+	return NewArgumentError(name, fmt.Errorf("missing required argument: The argument \"%s\" is required on vpc", name))
 }
 
 // ErrorRequiredArgOnClassic return error for required on classic
 func ErrorRequiredArgOnClassic(name string) error {
-	return fmt.Errorf("missing required argument: The argument \"%s\" is required on classic", name)
+	return NewArgumentError(name, fmt.Errorf("missing required argument: The argument \"%s\" is required on classic", name))
+}
+
+// ArgumentError error caused by an argument of the resource
+type ArgumentError struct {
+	Argument string
+	Err      error
+}
+
+// NewArgumentError return error pointing at the argument
+func NewArgumentError(argument string, err error) error {
+	return &ArgumentError{Argument: argument, Err: err}
+}
+
+func (e *ArgumentError) Error() string {
+	return e.Err.Error()
+}
+
+func (e *ArgumentError) Unwrap() error {
+	return e.Err
+}
+
+// ToDiagnostics return diagnostics of the error.
+// Errors of the API are detailed with the return code, a hint if known and the request id, and errors caused by an argument point at the argument.
+func ToDiagnostics(err error) diag.Diagnostics {
+	if err == nil {
+		return nil
+	}
+
+	d := diag.Diagnostic{
+		Severity: diag.Error,
+		Summary:  errorSummary(err),
+	}
+
+	if apiErr := asAPIError(err); apiErr != nil {
+		d.Detail = apiErr.detail()
+	}
+
+	var argErr *ArgumentError
+	if errors.As(err, &argErr) {
+		d.AttributePath = cty.GetAttrPath(argErr.Argument)
+	}
+
+	return diag.Diagnostics{d}
+}
+
+// errorSummary returns the message of err without the method and URL added by the HTTP client to errors of the API
+func errorSummary(err error) string {
+	var urlErr *url.Error
+	if errors.As(err, &urlErr) && asAPIError(urlErr.Err) != nil {
+		return strings.Replace(err.Error(), urlErr.Error(), urlErr.Err.Error(), 1)
+	}
+	return err.Error()
+}
+
+// attributeWarning return warning diagnostic pointing at the attribute
+func attributeWarning(attribute string, summary string, err error) diag.Diagnostic {
+	return diag.Diagnostic{
+		Severity:      diag.Warning,
+		Summary:       summary,
+		Detail:        err.Error(),
+		AttributePath: cty.GetAttrPath(attribute),
+	}
 }
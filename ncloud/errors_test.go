@@ -0,0 +1,32 @@
+package ncloud
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+)
+
+func TestToDiagnostics(t *testing.T) {
+	if diags := ToDiagnostics(nil); diags != nil {
+		t.Fatalf("Expected nil, Actual: %v", diags)
+	}
+
+	diags := ToDiagnostics(fmt.Errorf("error"))
+	if len(diags) != 1 || diags[0].Severity != diag.Error || diags[0].AttributePath != nil {
+		t.Fatalf("Expected error without attribute path, Actual: %v", diags)
+	}
+
+	err := fmt.Errorf("error creating server: %w", ErrorRequiredArgOnVpc("subnet_no"))
+	diags = ToDiagnostics(err)
+	if len(diags) != 1 || diags[0].Severity != diag.Error {
+		t.Fatalf("Expected 1 error, Actual: %v", diags)
+	}
+	if diags[0].Summary != err.Error() {
+		t.Fatalf("Expected: %s, Actual: %s", err.Error(), diags[0].Summary)
+	}
+	if !diags[0].AttributePath.Equals(cty.GetAttrPath("subnet_no")) {
+		t.Fatalf("Expected attribute path of subnet_no, Actual: %#v", diags[0].AttributePath)
+	}
+}
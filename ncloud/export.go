@@ -0,0 +1,592 @@
+package ncloud
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"os"
+	"path/filepath"
+	"reflect"
+	"regexp"
+	"sort"
+	"strings"
+
+	"github.com/NaverCloudPlatform/ncloud-sdk-go-v2/ncloud"
+	"github.com/hashicorp/hcl/v2"
+	"github.com/hashicorp/hcl/v2/hclwrite"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/zclconf/go-cty/cty"
+)
+
+// ExportOptions options of Export
+type ExportOptions struct {
+	// ResourceTypes types of resources to export. Default ExportResourceTypes()
+	ResourceTypes []string
+	// Dir directory of the generated files. Files are not written if empty.
+	Dir string
+}
+
+// ExportedResource resource read from the API by Export
+type ExportedResource struct {
+	Type string
+	Name string
+	ID   string
+
+	resource *schema.Resource
+	data     *schema.ResourceData
+}
+
+// exportItem resource found by the lister of the resource type
+type exportItem struct {
+	id   string
+	name string
+}
+
+type exportLister struct {
+	// vpcOnly the resource type is only supported on VPC
+	vpcOnly bool
+	list    func(ctx context.Context, config *ProviderConfig) ([]exportItem, error)
+}
+
+// exportListers listers of the resource types supported by Export
+var exportListers = map[string]exportLister{
+	"ncloud_vpc": {
+		vpcOnly: true,
+		list: func(ctx context.Context, config *ProviderConfig) ([]exportItem, error) {
+			resources, err := getVpcListFiltered(dataSourceNcloudVpc().Data(nil), config)
+			return exportItemsFromMaps(resources, "name"), err
+		},
+	},
+	"ncloud_subnet": {
+		vpcOnly: true,
+		list: func(ctx context.Context, config *ProviderConfig) ([]exportItem, error) {
+			resources, err := getSubnetListFiltered(dataSourceNcloudSubnet().Data(nil), config)
+			return exportItemsFromMaps(resources, "name"), err
+		},
+	},
+	"ncloud_access_control_group": {
+		vpcOnly: true,
+		list: func(ctx context.Context, config *ProviderConfig) ([]exportItem, error) {
+			resources, err := getVpcAccessControlGroupList(dataSourceNcloudAccessControlGroup().Data(nil), config)
+
+			// Default ACGs are created with the VPC
+			var userResources []map[string]interface{}
+			for _, r := range resources {
+				if isDefault, _ := r["is_default"].(bool); !isDefault {
+					userResources = append(userResources, r)
+				}
+			}
+			return exportItemsFromMaps(userResources, "name"), err
+		},
+	},
+	"ncloud_access_control_group_rule": {
+		vpcOnly: true,
+		list: func(ctx context.Context, config *ProviderConfig) ([]exportItem, error) {
+			resources, err := getVpcAccessControlGroupList(dataSourceNcloudAccessControlGroup().Data(nil), config)
+			return exportItemsFromMaps(resources, "name"), err
+		},
+	},
+	"ncloud_server": {
+		list: func(ctx context.Context, config *ProviderConfig) ([]exportItem, error) {
+			list, err := getServerList(dataSourceNcloudServer().Data(nil), config)
+			var items []exportItem
+			for _, r := range list {
+				items = append(items, exportItem{id: ncloud.StringValue(r.ServerInstanceNo), name: ncloud.StringValue(r.ServerName)})
+			}
+			return items, err
+		},
+	},
+	"ncloud_lb": {
+		vpcOnly: true,
+		list: func(ctx context.Context, config *ProviderConfig) ([]exportItem, error) {
+			list, err := getVpcLoadBalancerList(config, "")
+			var items []exportItem
+			for _, r := range list {
+				items = append(items, exportItem{id: ncloud.StringValue(r.LoadBalancerInstanceNo), name: ncloud.StringValue(r.LoadBalancerName)})
+			}
+			return items, err
+		},
+	},
+	"ncloud_lb_target_group": {
+		vpcOnly: true,
+		list: func(ctx context.Context, config *ProviderConfig) ([]exportItem, error) {
+			list, err := getVpcLoadBalancerTargetGroupList(config, "")
+			var items []exportItem
+			for _, r := range list {
+				items = append(items, exportItem{id: ncloud.StringValue(r.TargetGroupNo), name: ncloud.StringValue(r.TargetGroupName)})
+			}
+			return items, err
+		},
+	},
+	"ncloud_nks_cluster": {
+		vpcOnly: true,
+		list: func(ctx context.Context, config *ProviderConfig) ([]exportItem, error) {
+			list, err := getNKSClusters(ctx, config)
+			var items []exportItem
+			for _, r := range list {
+				items = append(items, exportItem{id: ncloud.StringValue(r.Uuid), name: ncloud.StringValue(r.Name)})
+			}
+			return items, err
+		},
+	},
+	"ncloud_nks_node_pool": {
+		vpcOnly: true,
+		list: func(ctx context.Context, config *ProviderConfig) ([]exportItem, error) {
+			clusters, err := getNKSClusters(ctx, config)
+			if err != nil {
+				return nil, err
+			}
+
+			var items []exportItem
+			for _, c := range clusters {
+				nodePools, err := getNKSNodePools(ctx, config, ncloud.StringValue(c.Uuid))
+				if err != nil {
+					return nil, err
+				}
+				for _, np := range nodePools {
+					items = append(items, exportItem{
+						id:   NodePoolCreateResourceID(ncloud.StringValue(c.Uuid), ncloud.StringValue(np.Name)),
+						name: ncloud.StringValue(c.Name) + "_" + ncloud.StringValue(np.Name),
+					})
+				}
+			}
+			return items, nil
+		},
+	},
+}
+
+// exportReferences types of resources referenced by the arguments.
+// Values of the arguments are replaced with references when the referenced resource is exported too.
+var exportReferences = map[string]string{
+	"vpc_no":                  "ncloud_vpc",
+	"subnet_no":               "ncloud_subnet",
+	"access_control_group_no": "ncloud_access_control_group",
+	"server_instance_no":      "ncloud_server",
+	"target_group_no":         "ncloud_lb_target_group",
+	"cluster_uuid":            "ncloud_nks_cluster",
+}
+
+// ExportResourceTypes returns the resource types supported by Export
+func ExportResourceTypes() []string {
+	var types []string
+	for t := range exportListers {
+		types = append(types, t)
+	}
+	sort.Strings(types)
+
+	return types
+}
+
+// Export reads the resources of the account in the region of the configured provider,
+// and writes Terraform configuration of them with import blocks to opts.Dir, one file per resource type.
+func Export(ctx context.Context, p *schema.Provider, opts ExportOptions) ([]*ExportedResource, error) {
+	config, ok := p.Meta().(*ProviderConfig)
+	if !ok {
+		return nil, fmt.Errorf("provider is not configured")
+	}
+
+	types := opts.ResourceTypes
+	if len(types) == 0 {
+		types = ExportResourceTypes()
+	}
+
+	var resources []*ExportedResource
+	for _, t := range types {
+		lister, ok := exportListers[t]
+		if !ok {
+			return nil, fmt.Errorf("resource type `%s` is not supported by export. Supported types: %s", t, strings.Join(ExportResourceTypes(), ", "))
+		}
+
+		if lister.vpcOnly && !config.SupportVPC {
+			log.Printf("[WARN] Skipping export of %s, which is not supported on classic", t)
+			continue
+		}
+
+		items, err := lister.list(ctx, config)
+		if err != nil {
+			return nil, fmt.Errorf("error listing %s: %s", t, err)
+		}
+
+		r := p.ResourcesMap[t]
+		names := map[string]bool{}
+		for _, item := range items {
+			d, err := readExportResource(ctx, r, item.id, config)
+			if err != nil {
+				return nil, fmt.Errorf("error reading %s (%s): %s", t, item.id, err)
+			}
+			if d == nil {
+				log.Printf("[WARN] %s (%s) is not found, skipping export", t, item.id)
+				continue
+			}
+
+			resources = append(resources, &ExportedResource{
+				Type:     t,
+				Name:     exportResourceName(item.name, names),
+				ID:       d.Id(),
+				resource: r,
+				data:     d,
+			})
+		}
+	}
+
+	if opts.Dir != "" {
+		if err := writeExportFiles(opts.Dir, resources); err != nil {
+			return nil, err
+		}
+	}
+
+	return resources, nil
+}
+
+// readExportResource reads the resource the same way as `terraform import`, or returns nil if not found
+func readExportResource(ctx context.Context, r *schema.Resource, id string, meta interface{}) (*schema.ResourceData, error) {
+	d := r.Data(nil)
+	d.SetId(id)
+
+	if r.Importer != nil && r.Importer.StateContext != nil {
+		imported, err := r.Importer.StateContext(ctx, d, meta)
+		if err != nil {
+			return nil, err
+		}
+		if len(imported) == 0 {
+			return nil, nil
+		}
+		d = imported[0]
+	}
+
+	if err := diagnosticsError(r.ReadContext(ctx, d, meta)); err != nil {
+		return nil, err
+	}
+
+	if d.Id() == "" {
+		return nil, nil
+	}
+
+	return d, nil
+}
+
+// diagnosticsError returns error of the error diagnostics, or nil if there's no error
+func diagnosticsError(diags diag.Diagnostics) error {
+	var errs []string
+	for _, d := range diags {
+		if d.Severity != diag.Error {
+			continue
+		}
+
+		if d.Detail != "" {
+			errs = append(errs, fmt.Sprintf("%s: %s", d.Summary, d.Detail))
+		} else {
+			errs = append(errs, d.Summary)
+		}
+	}
+
+	if len(errs) == 0 {
+		return nil
+	}
+
+	return fmt.Errorf("%s", strings.Join(errs, "; "))
+}
+
+func exportItemsFromMaps(resources []map[string]interface{}, nameKey string) []exportItem {
+	var items []exportItem
+	for _, r := range resources {
+		name, _ := r[nameKey].(string)
+		items = append(items, exportItem{id: r["id"].(string), name: name})
+	}
+
+	return items
+}
+
+var exportNameInvalidCharRegexp = regexp.MustCompile(`[^a-z0-9_-]+`)
+
+// exportResourceName returns the name of the resource block, unique in the names of the resource type
+func exportResourceName(name string, names map[string]bool) string {
+	name = strings.Trim(exportNameInvalidCharRegexp.ReplaceAllString(strings.ToLower(name), "_"), "_-")
+	if name == "" {
+		name = "resource"
+	}
+	if name[0] < 'a' || name[0] > 'z' {
+		name = "r_" + name
+	}
+
+	unique := name
+	for i := 2; names[unique]; i++ {
+		unique = fmt.Sprintf("%s_%d", name, i)
+	}
+	names[unique] = true
+
+	return unique
+}
+
+// writeExportFiles writes `<resource type>.tf` of the resources with an import block for each resource,
+// and `variables.tf` of the variables referenced by the required sensitive arguments, which can't be read from the API
+func writeExportFiles(dir string, resources []*ExportedResource) error {
+	// names of the exported resources by type and ID, for references
+	exported := map[string]map[string]string{}
+	for _, r := range resources {
+		if exported[r.Type] == nil {
+			exported[r.Type] = map[string]string{}
+		}
+		exported[r.Type][r.ID] = r.Name
+	}
+
+	var types []string
+	var variables []exportVariable
+	variableNames := map[string]bool{}
+	files := map[string]*hclwrite.File{}
+	for _, r := range resources {
+		f, ok := files[r.Type]
+		if !ok {
+			f = hclwrite.NewEmptyFile()
+			files[r.Type] = f
+			types = append(types, r.Type)
+		}
+
+		body := f.Body()
+		if len(body.Blocks()) > 0 {
+			body.AppendNewline()
+		}
+
+		importBody := body.AppendNewBlock("import", nil).Body()
+		importBody.SetAttributeTraversal("to", hcl.Traversal{hcl.TraverseRoot{Name: r.Type}, hcl.TraverseAttr{Name: r.Name}})
+		importBody.SetAttributeValue("id", cty.StringVal(r.ID))
+		body.AppendNewline()
+
+		values := map[string]interface{}{}
+		for k := range r.resource.Schema {
+			values[k] = r.data.Get(k)
+		}
+
+		address := r.Type + "." + r.Name
+		variable := func(key string) string {
+			name := exportResourceName(fmt.Sprintf("%s_%s_%s", r.Type, r.Name, key), variableNames)
+			variables = append(variables, exportVariable{name: name, description: fmt.Sprintf("Value of `%s` of %s", key, address)})
+			return name
+		}
+		writeExportBody(body.AppendNewBlock("resource", []string{r.Type, r.Name}).Body(), r.resource.Schema, values, exported, variable)
+	}
+
+	if len(variables) > 0 {
+		f := hclwrite.NewEmptyFile()
+		for i, v := range variables {
+			if i > 0 {
+				f.Body().AppendNewline()
+			}
+			variableBody := f.Body().AppendNewBlock("variable", []string{v.name}).Body()
+			variableBody.SetAttributeValue("description", cty.StringVal(v.description))
+			variableBody.SetAttributeValue("sensitive", cty.True)
+		}
+		files["variables"] = f
+		types = append(types, "variables")
+	}
+
+	if err := os.MkdirAll(dir, 0755); err != nil {
+		return err
+	}
+
+	for _, t := range types {
+		filename := filepath.Join(dir, t+".tf")
+		if err := os.WriteFile(filename, hclwrite.Format(files[t].Bytes()), 0644); err != nil {
+			return fmt.Errorf("error writing %s: %s", filename, err)
+		}
+	}
+
+	return nil
+}
+
+// exportVariable variable of the value which is not exported
+type exportVariable struct {
+	name        string
+	description string
+}
+
+// writeExportBody writes the arguments of the schema, skipping computed only attributes, defaults and arguments conflicting with written ones.
+// Required sensitive arguments are written as references to the variables returned by variable, the others are skipped.
+func writeExportBody(body *hclwrite.Body, s map[string]*schema.Schema, values map[string]interface{}, exported map[string]map[string]string, variable func(key string) string) {
+	written := map[string]bool{}
+	conflicts := func(sch *schema.Schema) bool {
+		for _, k := range append(append([]string{}, sch.ConflictsWith...), sch.ExactlyOneOf...) {
+			if written[k[strings.LastIndex(k, ".")+1:]] {
+				return true
+			}
+		}
+		return false
+	}
+
+	var blocks []string
+	for _, k := range exportArgumentKeys(s) {
+		sch, v := s[k], values[k]
+		if sch.Sensitive && sch.Required {
+			written[k] = true
+			body.SetAttributeTraversal(k, hcl.Traversal{hcl.TraverseRoot{Name: "var"}, hcl.TraverseAttr{Name: variable(k)}})
+			continue
+		}
+
+		if sch.Deprecated != "" || isExportDefault(sch, v) || conflicts(sch) {
+			continue
+		}
+
+		if sch.Sensitive {
+			log.Printf("[WARN] Skipping export of sensitive argument `%s`", k)
+			continue
+		}
+
+		written[k] = true
+		if _, ok := sch.Elem.(*schema.Resource); ok {
+			blocks = append(blocks, k)
+			continue
+		}
+
+		if id, ok := v.(string); ok {
+			if name, ok := exported[exportReferences[k]][id]; ok {
+				body.SetAttributeTraversal(k, hcl.Traversal{hcl.TraverseRoot{Name: exportReferences[k]}, hcl.TraverseAttr{Name: name}, hcl.TraverseAttr{Name: "id"}})
+				continue
+			}
+		}
+		body.SetAttributeValue(k, exportCtyValue(sch, v))
+	}
+
+	for _, k := range blocks {
+		elem := s[k].Elem.(*schema.Resource)
+		for _, item := range exportListValue(values[k]) {
+			if m, ok := item.(map[string]interface{}); ok {
+				writeExportBody(body.AppendNewBlock(k, nil).Body(), elem.Schema, m, exported, variable)
+			}
+		}
+	}
+}
+
+// exportArgumentKeys returns keys of the arguments of the schema, required ones first, then optional ones not computed
+func exportArgumentKeys(s map[string]*schema.Schema) []string {
+	rank := func(sch *schema.Schema) int {
+		switch {
+		case sch.Required:
+			return 0
+		case !sch.Computed:
+			return 1
+		default:
+			return 2
+		}
+	}
+
+	var keys []string
+	for k, sch := range s {
+		if sch.Required || sch.Optional {
+			keys = append(keys, k)
+		}
+	}
+
+	sort.Slice(keys, func(i, j int) bool {
+		if ri, rj := rank(s[keys[i]]), rank(s[keys[j]]); ri != rj {
+			return ri < rj
+		}
+		return keys[i] < keys[j]
+	})
+
+	return keys
+}
+
+// isExportDefault returns whether the value can be omitted from the configuration
+func isExportDefault(s *schema.Schema, v interface{}) bool {
+	if v == nil {
+		return true
+	}
+
+	if s.Default != nil {
+		return reflect.DeepEqual(s.Default, v)
+	}
+
+	if s.Required {
+		return false
+	}
+
+	switch v := v.(type) {
+	case []interface{}, *schema.Set:
+		return len(exportListValue(v)) == 0
+	case map[string]interface{}:
+		return len(v) == 0
+	default:
+		return reflect.ValueOf(v).IsZero()
+	}
+}
+
+func exportListValue(v interface{}) []interface{} {
+	switch v := v.(type) {
+	case *schema.Set:
+		return v.List()
+	case []interface{}:
+		return v
+	}
+
+	return nil
+}
+
+func exportCtyValue(s *schema.Schema, v interface{}) cty.Value {
+	switch s.Type {
+	case schema.TypeBool:
+		return cty.BoolVal(v.(bool))
+	case schema.TypeInt:
+		return cty.NumberIntVal(int64(v.(int)))
+	case schema.TypeFloat:
+		return cty.NumberFloatVal(v.(float64))
+	case schema.TypeList, schema.TypeSet:
+		elem, _ := s.Elem.(*schema.Schema)
+		if elem == nil {
+			elem = &schema.Schema{Type: schema.TypeString}
+		}
+
+		var vals []cty.Value
+		for _, item := range exportListValue(v) {
+			vals = append(vals, exportCtyValue(elem, item))
+		}
+		return exportCollectionValue(vals, s.Type == schema.TypeSet)
+	case schema.TypeMap:
+		elem, _ := s.Elem.(*schema.Schema)
+		if elem == nil {
+			elem = &schema.Schema{Type: schema.TypeString}
+		}
+
+		vals := map[string]cty.Value{}
+		for k, item := range v.(map[string]interface{}) {
+			vals[k] = exportCtyValue(elem, item)
+		}
+		if len(vals) == 0 {
+			return cty.MapValEmpty(cty.String)
+		}
+
+		var elemType cty.Type
+		for _, val := range vals {
+			if elemType == cty.NilType {
+				elemType = val.Type()
+			} else if !val.Type().Equals(elemType) {
+				return cty.ObjectVal(vals)
+			}
+		}
+		return cty.MapVal(vals)
+	default:
+		return cty.StringVal(fmt.Sprint(v))
+	}
+}
+
+// exportCollectionValue returns list or set value of the elements.
+// Elements of differing types, which can't be in a list or set, are written as a tuple, which is the same in HCL.
+func exportCollectionValue(vals []cty.Value, set bool) cty.Value {
+	if len(vals) == 0 {
+		if set {
+			return cty.SetValEmpty(cty.String)
+		}
+		return cty.ListValEmpty(cty.String)
+	}
+
+	for _, val := range vals[1:] {
+		if !val.Type().Equals(vals[0].Type()) {
+			return cty.TupleVal(vals)
+		}
+	}
+
+	if set {
+		return cty.SetVal(vals)
+	}
+	return cty.ListVal(vals)
+}
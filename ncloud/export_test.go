@@ -0,0 +1,211 @@
+package ncloud
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/hcl/v2/hclwrite"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+const testExportVpc = `{"vpcNo":"1","vpcName":"prod-vpc","ipv4CidrBlock":"10.0.0.0/16","vpcStatus":{"code":"RUN"}}`
+const testExportSubnet = `{"subnetNo":"40","vpcNo":"1","zoneCode":"KR-1","subnetName":"web subnet","subnet":"10.0.1.0/24","subnetType":{"code":"PUBLIC"},"usageType":{"code":"GEN"},"networkAclNo":"10","subnetStatus":{"code":"RUN"}}`
+
+var testExportAccessControlGroups = map[string]string{
+	"20": `{"accessControlGroupNo":"20","accessControlGroupName":"prod-vpc-default-acg","accessControlGroupDescription":"default","isDefault":true,"vpcNo":"1","accessControlGroupStatus":{"code":"RUN"}}`,
+	"21": `{"accessControlGroupNo":"21","accessControlGroupName":"web","accessControlGroupDescription":"web servers","isDefault":false,"vpcNo":"1","accessControlGroupStatus":{"code":"RUN"}}`,
+}
+
+// testExportProvider returns the provider configured with a fake API server of a VPC with a subnet and ACGs
+func testExportProvider(t *testing.T) *schema.Provider {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		action := r.URL.Path[strings.LastIndex(r.URL.Path, "/")+1:]
+		response := func(listName string, list ...string) {
+			fmt.Fprintf(w, `{"%sResponse": {"returnCode":"0","totalRows":%d,"%s":[%s]}}`, action, len(list), listName, strings.Join(list, ","))
+		}
+
+		switch r.URL.Path {
+		case "/vpc/v2/getVpcList", "/vpc/v2/getVpcDetail":
+			response("vpcList", testExportVpc)
+		case "/vpc/v2/getSubnetList", "/vpc/v2/getSubnetDetail":
+			response("subnetList", testExportSubnet)
+		case "/vpc/v2/getNetworkAclList":
+			response("networkAclList", `{"networkAclNo":"10","isDefault":true}`)
+		case "/vpc/v2/getRouteTableList":
+			response("routeTableList", `{"routeTableNo":"30","isDefault":true,"supportedSubnetType":{"code":"PUBLIC"}}`, `{"routeTableNo":"31","isDefault":true,"supportedSubnetType":{"code":"PRIVATE"}}`)
+		case "/vserver/v2/getAccessControlGroupList":
+			response("accessControlGroupList", testExportAccessControlGroups["20"], testExportAccessControlGroups["21"])
+		case "/vserver/v2/getAccessControlGroupDetail":
+			response("accessControlGroupList", testExportAccessControlGroups[r.FormValue("accessControlGroupNo")])
+		default:
+			t.Errorf("Unexpected path: %s", r.URL.Path)
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	t.Cleanup(ts.Close)
+	t.Setenv("NCLOUD_API_GW", ts.URL)
+
+	config := testPlatformProviderConfig(t, map[string]interface{}{"support_vpc": true})
+	p := Provider()
+	p.SetMeta(config)
+
+	return p
+}
+
+func TestExport(t *testing.T) {
+	p := testExportProvider(t)
+	dir := t.TempDir()
+
+	resources, err := Export(context.Background(), p, ExportOptions{
+		ResourceTypes: []string{"ncloud_vpc", "ncloud_subnet", "ncloud_access_control_group"},
+		Dir:           dir,
+	})
+	if err != nil {
+		t.Fatalf("Unexpected error: %s", err)
+	}
+
+	var actual []string
+	for _, r := range resources {
+		actual = append(actual, fmt.Sprintf("%s.%s=%s", r.Type, r.Name, r.ID))
+	}
+	expected := "ncloud_vpc.prod-vpc=1,ncloud_subnet.web_subnet=40,ncloud_access_control_group.web=21"
+	if strings.Join(actual, ",") != expected {
+		t.Fatalf("Expected: %s, Actual: %s", expected, strings.Join(actual, ","))
+	}
+
+	cases := map[string]string{
+		"ncloud_vpc.tf": `import {
+  to = ncloud_vpc.prod-vpc
+  id = "1"
+}
+
+resource "ncloud_vpc" "prod-vpc" {
+  ipv4_cidr_block = "10.0.0.0/16"
+  name            = "prod-vpc"
+}
+`,
+		"ncloud_subnet.tf": `import {
+  to = ncloud_subnet.web_subnet
+  id = "40"
+}
+
+resource "ncloud_subnet" "web_subnet" {
+  network_acl_no = "10"
+  subnet         = "10.0.1.0/24"
+  subnet_type    = "PUBLIC"
+  vpc_no         = ncloud_vpc.prod-vpc.id
+  zone           = "KR-1"
+  name           = "web subnet"
+  usage_type     = "GEN"
+}
+`,
+		"ncloud_access_control_group.tf": `import {
+  to = ncloud_access_control_group.web
+  id = "21"
+}
+
+resource "ncloud_access_control_group" "web" {
+  vpc_no      = ncloud_vpc.prod-vpc.id
+  description = "web servers"
+  name        = "web"
+}
+`,
+	}
+
+	for filename, expected := range cases {
+		b, err := os.ReadFile(filepath.Join(dir, filename))
+		if err != nil {
+			t.Fatalf("Unexpected error: %s", err)
+		}
+
+		if string(b) != expected {
+			t.Fatalf("Expected: %s, Actual: %s", expected, b)
+		}
+	}
+}
+
+func TestExport_unsupportedType(t *testing.T) {
+	p := testExportProvider(t)
+
+	if _, err := Export(context.Background(), p, ExportOptions{ResourceTypes: []string{"ncloud_route"}}); err == nil {
+		t.Fatal("Expected error for unsupported resource type")
+	}
+}
+
+func TestExportResourceName(t *testing.T) {
+	names := map[string]bool{}
+
+	cases := []struct {
+		name     string
+		expected string
+	}{
+		{"web", "web"},
+		{"web", "web_2"},
+		{"Web Server #1", "web_server_1"},
+		{"1st", "r_1st"},
+		{"", "resource"},
+	}
+
+	for _, tc := range cases {
+		if actual := exportResourceName(tc.name, names); actual != tc.expected {
+			t.Fatalf("Expected: %s, Actual: %s", tc.expected, actual)
+		}
+	}
+}
+
+func TestExportCtyValue_set(t *testing.T) {
+	s := &schema.Schema{Type: schema.TypeSet, Elem: &schema.Schema{Type: schema.TypeString}}
+	v := schema.NewSet(schema.HashString, []interface{}{"b", "a"})
+
+	if actual := exportCtyValue(s, v); !actual.Type().IsSetType() || actual.LengthInt() != 2 {
+		t.Fatalf("Expected set of 2 elements, Actual: %#v", actual)
+	}
+
+	// Elements of differing types must not panic
+	s = &schema.Schema{Type: schema.TypeSet, Elem: &schema.Schema{Type: schema.TypeMap, Elem: &schema.Schema{Type: schema.TypeBool}}}
+	v = schema.NewSet(func(v interface{}) int { return len(v.(map[string]interface{})) }, []interface{}{
+		map[string]interface{}{},
+		map[string]interface{}{"enabled": true},
+	})
+
+	if actual := exportCtyValue(s, v); actual.LengthInt() != 2 {
+		t.Fatalf("Expected 2 elements, Actual: %#v", actual)
+	}
+}
+
+func TestWriteExportBody_requiredSensitive(t *testing.T) {
+	s := map[string]*schema.Schema{
+		"name":          {Type: schema.TypeString, Required: true},
+		"private_key":   {Type: schema.TypeString, Required: true, Sensitive: true},
+		"user_password": {Type: schema.TypeString, Optional: true, Sensitive: true},
+	}
+	values := map[string]interface{}{
+		"name":          "cert",
+		"private_key":   "",
+		"user_password": "secret",
+	}
+
+	f := hclwrite.NewEmptyFile()
+	var variables []string
+	writeExportBody(f.Body(), s, values, nil, func(key string) string {
+		variables = append(variables, key)
+		return "ncloud_test_cert_" + key
+	})
+
+	expected := `name        = "cert"
+private_key = var.ncloud_test_cert_private_key
+`
+	if actual := string(hclwrite.Format(f.Bytes())); actual != expected {
+		t.Fatalf("Expected: %s, Actual: %s", expected, actual)
+	}
+
+	if strings.Join(variables, ",") != "private_key" {
+		t.Fatalf("Expected variable of private_key only, Actual: %v", variables)
+	}
+}
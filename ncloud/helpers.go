@@ -21,6 +21,8 @@ func GetDataSourceItemSchema(resourceSchema *schema.Resource) *schema.Resource {
 	// as though they were resources.
 	resourceSchema.Create = nil
 	resourceSchema.Read = nil
+	resourceSchema.CreateContext = nil
+	resourceSchema.ReadContext = nil
 
 	return convertResourceFieldsToDatasourceFields(resourceSchema)
 }
@@ -40,6 +42,10 @@ func GetSingularDataSourceItemSchema(resourceSchema *schema.Resource, addFieldMa
 	resourceSchema.Update = nil
 	resourceSchema.Delete = nil
 	resourceSchema.Read = readFunc
+	resourceSchema.CreateContext = nil
+	resourceSchema.UpdateContext = nil
+	resourceSchema.DeleteContext = nil
+	resourceSchema.ReadContext = nil
 	resourceSchema.Importer = nil
 	resourceSchema.Timeouts = nil
 	resourceSchema.CustomizeDiff = nil
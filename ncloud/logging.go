@@ -0,0 +1,235 @@
+package ncloud
+
+import (
+	"bytes"
+	"encoding/json"
+	"io/ioutil"
+	"log"
+	"net/http"
+	"net/url"
+	"os"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+const (
+	// EnvLogProviderNcloud enables HTTP trace logging of API calls. `DEBUG` logs method, URL, status, latency and request id, `TRACE` also logs the bodies.
+	EnvLogProviderNcloud = "TF_LOG_PROVIDER_NCLOUD"
+
+	redactedLogValue = "***"
+)
+
+var (
+	sensitiveLogKeysMu sync.RWMutex
+
+	// sensitiveLogKeys normalized names of values redacted from logs, extended with attributes marked `Sensitive` in the schema
+	sensitiveLogKeys = map[string]bool{
+		"password":             true,
+		"secretkey":            true,
+		"privatekey":           true,
+		"xncpiamaccesskey":     true,
+		"xncpapigwsignaturev1": true,
+		"xncpapigwsignaturev2": true,
+		"xncpapigwapikey":      true,
+		// kubeconfig of NKS clusters and its client credentials
+		"kubeconfig":            true,
+		"clientkeydata":         true,
+		"clientcertificatedata": true,
+	}
+)
+
+// normalizeLogKey matches schema attributes, API fields and headers, e.g. `cifs_user_password` to `cifsUserPassword` and `client-key-data` to `clientKeyData`
+func normalizeLogKey(key string) string {
+	return strings.ToLower(strings.NewReplacer("_", "", "-", "").Replace(key))
+}
+
+func isSensitiveLogKey(key string) bool {
+	sensitiveLogKeysMu.RLock()
+	defer sensitiveLogKeysMu.RUnlock()
+
+	return sensitiveLogKeys[normalizeLogKey(key)]
+}
+
+// registerSensitiveLogKeys adds attributes marked `Sensitive` in the schema of the resources to the redaction list
+func registerSensitiveLogKeys(resources map[string]*schema.Resource) {
+	sensitiveLogKeysMu.Lock()
+	defer sensitiveLogKeysMu.Unlock()
+
+	for _, r := range resources {
+		addSensitiveLogKeys(r.Schema)
+	}
+}
+
+func addSensitiveLogKeys(schemaMap map[string]*schema.Schema) {
+	for k, s := range schemaMap {
+		if s.Sensitive {
+			sensitiveLogKeys[normalizeLogKey(k)] = true
+		}
+
+		if elem, ok := s.Elem.(*schema.Resource); ok {
+			addSensitiveLogKeys(elem.Schema)
+		}
+	}
+}
+
+// redactLogValue returns JSON of v with sensitive values redacted
+func redactLogValue(v interface{}) string {
+	b, err := json.Marshal(v)
+	if err != nil {
+		return ""
+	}
+	return string(redactJSON(b))
+}
+
+// redactJSON returns the JSON with sensitive values redacted, or the input if it is not JSON
+func redactJSON(b []byte) []byte {
+	var v interface{}
+	if err := json.Unmarshal(b, &v); err != nil {
+		return b
+	}
+
+	redacted, err := json.Marshal(redactValue(v))
+	if err != nil {
+		return b
+	}
+	return redacted
+}
+
+func redactValue(v interface{}) interface{} {
+	switch v := v.(type) {
+	case map[string]interface{}:
+		for k, e := range v {
+			if isSensitiveLogKey(k) {
+				v[k] = redactedLogValue
+			} else {
+				v[k] = redactValue(e)
+			}
+		}
+	case []interface{}:
+		for i, e := range v {
+			v[i] = redactValue(e)
+		}
+	}
+	return v
+}
+
+// redactQuery returns the encoded query with sensitive values redacted
+func redactQuery(values url.Values) string {
+	redacted := url.Values{}
+	for k, v := range values {
+		if isSensitiveLogKey(k) {
+			redacted[k] = []string{redactedLogValue}
+		} else {
+			redacted[k] = v
+		}
+	}
+	return redacted.Encode()
+}
+
+func redactURL(u *url.URL) string {
+	redacted := *u
+	redacted.RawQuery = redactQuery(u.Query())
+	return redacted.String()
+}
+
+func redactHeader(header http.Header) http.Header {
+	redacted := http.Header{}
+	for k, v := range header {
+		if isSensitiveLogKey(k) {
+			redacted[k] = []string{redactedLogValue}
+		} else {
+			redacted[k] = v
+		}
+	}
+	return redacted
+}
+
+// redactBody returns the body with sensitive values redacted, either JSON or form encoded
+func redactBody(contentType string, body []byte) string {
+	if strings.HasPrefix(contentType, "application/x-www-form-urlencoded") {
+		if values, err := url.ParseQuery(string(body)); err == nil {
+			return redactQuery(values)
+		}
+	}
+	return string(redactJSON(body))
+}
+
+// providerLogLevel returns the level of EnvLogProviderNcloud, or "" if HTTP trace logging is disabled
+func providerLogLevel() string {
+	switch level := strings.ToUpper(os.Getenv(EnvLogProviderNcloud)); level {
+	case "TRACE", "DEBUG":
+		return level
+	}
+	return ""
+}
+
+// loggingTransport logs every API call including retries with sensitive values redacted
+type loggingTransport struct {
+	transport http.RoundTripper
+	level     string
+}
+
+func (t *loggingTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	if t.level == "TRACE" {
+		log.Printf("[TRACE] ncloud HTTP request: %s %s header=%v body=%s", req.Method, redactURL(req.URL), redactHeader(req.Header), redactBody(req.Header.Get("Content-Type"), readRequestBody(req)))
+	}
+
+	start := time.Now()
+	resp, err := t.transport.RoundTrip(req)
+	latency := time.Since(start).Round(time.Millisecond)
+	if err != nil {
+		log.Printf("[DEBUG] ncloud HTTP %s %s error=%s latency=%s", req.Method, redactURL(req.URL), err, latency)
+		return resp, err
+	}
+
+	body, err := ioutil.ReadAll(resp.Body)
+	resp.Body.Close()
+	resp.Body = ioutil.NopCloser(bytes.NewReader(body))
+	if err != nil {
+		return nil, err
+	}
+
+	log.Printf("[DEBUG] ncloud HTTP %s %s status=%d latency=%s request_id=%s", req.Method, redactURL(req.URL), resp.StatusCode, latency, responseRequestId(body))
+	if t.level == "TRACE" {
+		log.Printf("[TRACE] ncloud HTTP response: %s %s body=%s", req.Method, redactURL(req.URL), redactBody(resp.Header.Get("Content-Type"), body))
+	}
+
+	return resp, nil
+}
+
+// readRequestBody returns a copy of the request body without consuming it
+func readRequestBody(req *http.Request) []byte {
+	if req.Body == nil || req.GetBody == nil {
+		return nil
+	}
+
+	body, err := req.GetBody()
+	if err != nil {
+		return nil
+	}
+	defer body.Close()
+
+	b, _ := ioutil.ReadAll(body)
+	return b
+}
+
+// responseRequestId returns `requestId` of the response body, e.g. {"getServerInstanceListResponse": {"requestId": "..."}}
+func responseRequestId(body []byte) string {
+	var v map[string]json.RawMessage
+	if err := json.Unmarshal(body, &v); err != nil {
+		return ""
+	}
+
+	for _, raw := range v {
+		var resp struct {
+			RequestId string `json:"requestId"`
+		}
+		if err := json.Unmarshal(raw, &resp); err == nil && resp.RequestId != "" {
+			return resp.RequestId
+		}
+	}
+	return ""
+}
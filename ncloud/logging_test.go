@@ -0,0 +1,133 @@
+package ncloud
+
+import (
+	"bytes"
+	"log"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/NaverCloudPlatform/ncloud-sdk-go-v2/ncloud"
+	"github.com/NaverCloudPlatform/ncloud-sdk-go-v2/services/server"
+	"github.com/NaverCloudPlatform/ncloud-sdk-go-v2/services/vnas"
+	"github.com/NaverCloudPlatform/ncloud-sdk-go-v2/services/vnks"
+	"github.com/NaverCloudPlatform/ncloud-sdk-go-v2/services/vserver"
+)
+
+func testCaptureLog(t *testing.T) *bytes.Buffer {
+	var buf bytes.Buffer
+	log.SetOutput(&buf)
+	t.Cleanup(func() { log.SetOutput(os.Stderr) })
+	return &buf
+}
+
+func TestRedactLogValue_schemaSensitive(t *testing.T) {
+	Provider()
+
+	cases := []interface{}{
+		&server.GetRootPasswordRequest{ServerInstanceNo: ncloud.String("1234"), PrivateKey: ncloud.String("SECRET-PRIVATE-KEY")},
+		&vserver.GetRootPasswordResponse{RootPassword: ncloud.String("SECRET-ROOT-PASSWORD")},
+		&vnas.CreateNasVolumeInstanceRequest{VolumeName: ncloud.String("nas"), CifsUserPassword: ncloud.String("SECRET-CIFS-PASSWORD")},
+		&server.CreateLoginKeyResponse{PrivateKey: ncloud.String("SECRET-LOGIN-KEY")},
+	}
+
+	for _, c := range cases {
+		redacted := redactLogValue(c)
+		if strings.Contains(redacted, "SECRET") || !strings.Contains(redacted, redactedLogValue) {
+			t.Fatalf("Expected sensitive value to be redacted, Actual: %s", redacted)
+		}
+	}
+
+	if redacted := redactLogValue(&vnas.CreateNasVolumeInstanceRequest{VolumeName: ncloud.String("nas")}); !strings.Contains(redacted, `"volumeName":"nas"`) || strings.Contains(redacted, redactedLogValue) {
+		t.Fatalf("Expected value not to be redacted, Actual: %s", redacted)
+	}
+
+	if redacted := redactLogValue("certificate-name"); redacted != `"certificate-name"` {
+		t.Fatalf("Expected: %s, Actual: %s", `"certificate-name"`, redacted)
+	}
+}
+
+func TestLogCommonRequest_redacted(t *testing.T) {
+	Provider()
+	buf := testCaptureLog(t)
+
+	logCommonRequest("getClassicRootPassword", &server.GetRootPasswordRequest{ServerInstanceNo: ncloud.String("1234"), PrivateKey: ncloud.String("SECRET-PRIVATE-KEY")})
+
+	if strings.Contains(buf.String(), "SECRET") || !strings.Contains(buf.String(), `"serverInstanceNo":"1234"`) {
+		t.Fatalf("Expected private key to be redacted, Actual: %s", buf.String())
+	}
+}
+
+func TestLogResponse_kubeconfig(t *testing.T) {
+	buf := testCaptureLog(t)
+
+	kubeconfig := "apiVersion: v1\nusers:\n- name: admin\n  user:\n    client-certificate-data: SECRET-CERT\n    client-key-data: SECRET-KEY\n"
+	logResponse("getNKSKubeConfig", &vnks.KubeconfigRes{Kubeconfig: ncloud.String(kubeconfig)})
+
+	if strings.Contains(buf.String(), "SECRET") || !strings.Contains(buf.String(), redactedLogValue) {
+		t.Fatalf("Expected kubeconfig to be redacted, Actual: %s", buf.String())
+	}
+
+	// kubeconfig decoded to JSON, e.g. by the trace logging of the body
+	redacted := string(redactJSON([]byte(`{"users":[{"name":"admin","user":{"client-certificate-data":"SECRET-CERT","client-key-data":"SECRET-KEY"}}]}`)))
+	if strings.Contains(redacted, "SECRET") || !strings.Contains(redacted, `"name":"admin"`) {
+		t.Fatalf("Expected client credentials to be redacted, Actual: %s", redacted)
+	}
+}
+
+func TestProviderLogLevel(t *testing.T) {
+	cases := map[string]string{
+		"":      "",
+		"INFO":  "",
+		"debug": "DEBUG",
+		"TRACE": "TRACE",
+	}
+
+	for env, expected := range cases {
+		os.Setenv(EnvLogProviderNcloud, env)
+		if actual := providerLogLevel(); actual != expected {
+			t.Fatalf("Expected level for %q: %q, Actual: %q", env, expected, actual)
+		}
+	}
+	os.Unsetenv(EnvLogProviderNcloud)
+}
+
+func TestConfig_ClientLogging(t *testing.T) {
+	Provider()
+
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(`{"getRootPasswordResponse": {"requestId":"req-1234","returnCode":"0","rootPassword":"SECRET-ROOT-PASSWORD"}}`))
+	}))
+	defer ts.Close()
+
+	os.Setenv(EnvLogProviderNcloud, "TRACE")
+	defer os.Unsetenv(EnvLogProviderNcloud)
+
+	client, err := (&Config{
+		AccessKey: "access",
+		SecretKey: "SECRET-ACCESS-SECRET",
+		Region:    "KR",
+		Endpoints: map[string]string{"server": ts.URL + "/server/v2"},
+	}).Client()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	buf := testCaptureLog(t)
+	if _, err := client.server.V2Api.GetRootPassword(&server.GetRootPasswordRequest{ServerInstanceNo: ncloud.String("1234"), PrivateKey: ncloud.String("SECRET-PRIVATE-KEY")}); err != nil {
+		t.Fatalf("Unexpected error: %s", err)
+	}
+
+	logs := buf.String()
+	if strings.Contains(logs, "SECRET") {
+		t.Fatalf("Expected sensitive values to be redacted, Actual: %s", logs)
+	}
+
+	for _, expected := range []string{"/server/v2/getRootPassword", "status=200", "latency=", "request_id=req-1234", "serverInstanceNo=1234"} {
+		if !strings.Contains(logs, expected) {
+			t.Fatalf("Expected log to contain %s, Actual: %s", expected, logs)
+		}
+	}
+}
@@ -0,0 +1,260 @@
+package ncloud
+
+import (
+	"bytes"
+	"context"
+	"crypto"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/NaverCloudPlatform/ncloud-sdk-go-v2/hmac"
+	"github.com/NaverCloudPlatform/ncloud-sdk-go-v2/ncloud"
+	"github.com/NaverCloudPlatform/ncloud-sdk-go-v2/services/vnks"
+)
+
+// NKSNodePoolLabel kubernetes label of the nodes in a node pool
+type NKSNodePoolLabel struct {
+	Key   *string `json:"key"`
+	Value *string `json:"value"`
+}
+
+// NKSNodePoolTaint kubernetes taint of the nodes in a node pool
+type NKSNodePoolTaint struct {
+	Key    *string `json:"key"`
+	Value  *string `json:"value"`
+	Effect *string `json:"effect"`
+}
+
+// NKSNodePoolRes vnks.NodePoolRes with the node pool fields missing in the SDK
+type NKSNodePoolRes struct {
+	vnks.NodePoolRes
+
+	Labels []*NKSNodePoolLabel `json:"labels,omitempty"`
+	Taints []*NKSNodePoolTaint `json:"taints,omitempty"`
+}
+
+type NKSNodePoolsRes struct {
+	NodePool []*NKSNodePoolRes `json:"nodePool"`
+}
+
+// NKSNodePoolCreationBody vnks.NodePoolCreationBody with the node pool fields missing in the SDK
+type NKSNodePoolCreationBody struct {
+	vnks.NodePoolCreationBody
+
+	Label []*NKSNodePoolLabel `json:"label,omitempty"`
+	Taint []*NKSNodePoolTaint `json:"taint,omitempty"`
+}
+
+type NKSUpdateNodePoolLabelBody struct {
+	Labels []*NKSNodePoolLabel `json:"labels"`
+}
+
+type NKSUpdateNodePoolTaintBody struct {
+	Taints []*NKSNodePoolTaint `json:"taints"`
+}
+
+// NKSOidcRes OIDC identity provider configuration of the cluster
+type NKSOidcRes struct {
+	Status         *bool   `json:"status"`
+	IssuerURL      *string `json:"issuerURL,omitempty"`
+	ClientId       *string `json:"clientId,omitempty"`
+	UsernameClaim  *string `json:"usernameClaim,omitempty"`
+	UsernamePrefix *string `json:"usernamePrefix,omitempty"`
+	GroupsClaim    *string `json:"groupsClaim,omitempty"`
+	GroupsPrefix   *string `json:"groupsPrefix,omitempty"`
+	RequiredClaim  *string `json:"requiredClaim,omitempty"`
+}
+
+// NKSIpAclsEntry IP ACL entry of the cluster API endpoint
+type NKSIpAclsEntry struct {
+	Address *string `json:"address"`
+	Action  *string `json:"action"`
+	Comment *string `json:"comment,omitempty"`
+}
+
+// NKSIpAclsRes IP ACL of the cluster API endpoint
+type NKSIpAclsRes struct {
+	DefaultAction *string           `json:"defaultAction"`
+	Entries       []*NKSIpAclsEntry `json:"entries"`
+}
+
+// nksExtAPIClient calls the NKS API operations that are not generated in the vnks package of ncloud-sdk-go-v2 yet.
+// Method names follow the SDK naming so that they can be swapped for the generated ones once available.
+type nksExtAPIClient struct {
+	cfg *ncloud.Configuration
+}
+
+func newNKSExtAPIClient(cfg *ncloud.Configuration) *nksExtAPIClient {
+	if cfg.HTTPClient == nil {
+		cfg.HTTPClient = http.DefaultClient
+	}
+	return &nksExtAPIClient{cfg: cfg}
+}
+
+// ClustersUuidUpgradePatch upgrades the control plane of the cluster to k8sVersion
+func (c *nksExtAPIClient) ClustersUuidUpgradePatch(ctx context.Context, uuid *string, k8sVersion *string) error {
+	path := fmt.Sprintf("/clusters/%s/upgrade", ncloud.StringValue(uuid))
+	query := url.Values{}
+	query.Set("k8sVersion", ncloud.StringValue(k8sVersion))
+
+	return c.callAPI(ctx, http.MethodPatch, path, query, nil, nil)
+}
+
+// ClustersUuidNodePoolInstanceNoUpgradePatch upgrades the nodes of the node pool to k8sVersion
+func (c *nksExtAPIClient) ClustersUuidNodePoolInstanceNoUpgradePatch(ctx context.Context, uuid *string, instanceNo *string, k8sVersion *string) error {
+	path := fmt.Sprintf("/clusters/%s/node-pool/%s/upgrade", ncloud.StringValue(uuid), ncloud.StringValue(instanceNo))
+	query := url.Values{}
+	query.Set("k8sVersion", ncloud.StringValue(k8sVersion))
+
+	return c.callAPI(ctx, http.MethodPatch, path, query, nil, nil)
+}
+
+// ClustersUuidNodePoolGet returns node pools of the cluster including labels and taints
+func (c *nksExtAPIClient) ClustersUuidNodePoolGet(ctx context.Context, uuid *string) (*NKSNodePoolsRes, error) {
+	path := fmt.Sprintf("/clusters/%s/node-pool", ncloud.StringValue(uuid))
+	resp := &NKSNodePoolsRes{}
+
+	if err := c.callAPI(ctx, http.MethodGet, path, url.Values{}, nil, resp); err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+// ClustersUuidNodePoolPost creates node pool of the cluster including labels and taints
+func (c *nksExtAPIClient) ClustersUuidNodePoolPost(ctx context.Context, body *NKSNodePoolCreationBody, uuid *string) error {
+	path := fmt.Sprintf("/clusters/%s/node-pool", ncloud.StringValue(uuid))
+
+	return c.callAPI(ctx, http.MethodPost, path, url.Values{}, body, nil)
+}
+
+// ClustersUuidNodePoolInstanceNoLabelsPut replaces labels of the node pool
+func (c *nksExtAPIClient) ClustersUuidNodePoolInstanceNoLabelsPut(ctx context.Context, body *NKSUpdateNodePoolLabelBody, uuid *string, instanceNo *string) error {
+	path := fmt.Sprintf("/clusters/%s/node-pool/%s/labels", ncloud.StringValue(uuid), ncloud.StringValue(instanceNo))
+
+	return c.callAPI(ctx, http.MethodPut, path, url.Values{}, body, nil)
+}
+
+// ClustersUuidNodePoolInstanceNoTaintsPut replaces taints of the node pool
+func (c *nksExtAPIClient) ClustersUuidNodePoolInstanceNoTaintsPut(ctx context.Context, body *NKSUpdateNodePoolTaintBody, uuid *string, instanceNo *string) error {
+	path := fmt.Sprintf("/clusters/%s/node-pool/%s/taints", ncloud.StringValue(uuid), ncloud.StringValue(instanceNo))
+
+	return c.callAPI(ctx, http.MethodPut, path, url.Values{}, body, nil)
+}
+
+// ClustersUuidOidcGet returns OIDC configuration of the cluster
+func (c *nksExtAPIClient) ClustersUuidOidcGet(ctx context.Context, uuid *string) (*NKSOidcRes, error) {
+	path := fmt.Sprintf("/clusters/%s/oidc", ncloud.StringValue(uuid))
+	resp := &NKSOidcRes{}
+
+	if err := c.callAPI(ctx, http.MethodGet, path, url.Values{}, nil, resp); err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+// ClustersUuidOidcPatch updates OIDC configuration of the cluster
+func (c *nksExtAPIClient) ClustersUuidOidcPatch(ctx context.Context, body *NKSOidcRes, uuid *string) error {
+	path := fmt.Sprintf("/clusters/%s/oidc", ncloud.StringValue(uuid))
+
+	return c.callAPI(ctx, http.MethodPatch, path, url.Values{}, body, nil)
+}
+
+// ClustersUuidIpAclGet returns IP ACL of the cluster API endpoint
+func (c *nksExtAPIClient) ClustersUuidIpAclGet(ctx context.Context, uuid *string) (*NKSIpAclsRes, error) {
+	path := fmt.Sprintf("/clusters/%s/ip-acl", ncloud.StringValue(uuid))
+	resp := &NKSIpAclsRes{}
+
+	if err := c.callAPI(ctx, http.MethodGet, path, url.Values{}, nil, resp); err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+// ClustersUuidIpAclPatch replaces IP ACL of the cluster API endpoint
+func (c *nksExtAPIClient) ClustersUuidIpAclPatch(ctx context.Context, body *NKSIpAclsRes, uuid *string) error {
+	path := fmt.Sprintf("/clusters/%s/ip-acl", ncloud.StringValue(uuid))
+
+	return c.callAPI(ctx, http.MethodPatch, path, url.Values{}, body, nil)
+}
+
+// isNKSNotSupportedError returns whether err is the response of an API that does not exist or is not supported for the cluster
+func isNKSNotSupportedError(err error) bool {
+	apiErr := asAPIError(err)
+	if apiErr == nil {
+		return false
+	}
+
+	switch apiErr.StatusCode {
+	case http.StatusNotFound, http.StatusMethodNotAllowed, http.StatusNotImplemented:
+		return true
+	}
+	return false
+}
+
+func (c *nksExtAPIClient) callAPI(ctx context.Context, method string, path string, query url.Values, body interface{}, result interface{}) error {
+	u, err := url.Parse(c.cfg.BasePath + path)
+	if err != nil {
+		return err
+	}
+	u.RawQuery = query.Encode()
+
+	var reqBody *bytes.Buffer
+	if body != nil {
+		b, err := json.Marshal(body)
+		if err != nil {
+			return err
+		}
+		reqBody = bytes.NewBuffer(b)
+	} else {
+		reqBody = &bytes.Buffer{}
+	}
+
+	req, err := http.NewRequestWithContext(ctx, method, u.String(), reqBody)
+	if err != nil {
+		return err
+	}
+
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("Accept", "application/json")
+	req.Header.Set("User-Agent", c.cfg.UserAgent)
+	if c.cfg.Host != "" {
+		req.Host = c.cfg.Host
+	}
+
+	if auth := c.cfg.GetCredentials(); auth != nil {
+		timestamp := strconv.FormatInt(time.Now().UnixNano()/int64(time.Millisecond), 10)
+		signer := hmac.NewSigner(auth.SecretKey(), crypto.SHA256)
+		signature, _ := signer.Sign(method, u.String(), auth.AccessKey(), timestamp)
+
+		req.Header.Set("x-ncp-apigw-timestamp", timestamp)
+		req.Header.Set("x-ncp-iam-access-key", auth.AccessKey())
+		req.Header.Set("x-ncp-apigw-signature-v1", signature)
+	}
+
+	for header, value := range c.cfg.DefaultHeader {
+		req.Header.Add(header, value)
+	}
+
+	resp, err := c.cfg.HTTPClient.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	respBody, _ := ioutil.ReadAll(resp.Body)
+
+	if resp.StatusCode >= 300 {
+		return fmt.Errorf("Status: %v, Body: %s", resp.Status, respBody)
+	}
+
+	if result != nil && strings.HasPrefix(string(respBody), "{") {
+		return json.Unmarshal(respBody, result)
+	}
+
+	return nil
+}
@@ -0,0 +1,125 @@
+package ncloud
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/NaverCloudPlatform/ncloud-sdk-go-v2/ncloud"
+)
+
+func testNKSExtAPIClient(t *testing.T, handler http.HandlerFunc) *nksExtAPIClient {
+	ts := httptest.NewServer(handler)
+	t.Cleanup(ts.Close)
+
+	cfg := &ncloud.Configuration{
+		BasePath:      ts.URL + "/vnks/v2",
+		DefaultHeader: make(map[string]string),
+		APIKey:        &ncloud.APIKey{AccessKey: "access", SecretKey: "secret"},
+	}
+	cfg.InitCredentials()
+
+	return newNKSExtAPIClient(cfg)
+}
+
+func TestNKSExtAPIClient_ClustersUuidUpgradePatch(t *testing.T) {
+	client := testNKSExtAPIClient(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPatch {
+			t.Errorf("Expected method: PATCH, Actual: %s", r.Method)
+		}
+		if r.URL.Path != "/vnks/v2/clusters/uuid-1/upgrade" {
+			t.Errorf("Expected path: /vnks/v2/clusters/uuid-1/upgrade, Actual: %s", r.URL.Path)
+		}
+		if v := r.URL.Query().Get("k8sVersion"); v != "1.21.9-nks.1" {
+			t.Errorf("Expected k8sVersion: 1.21.9-nks.1, Actual: %s", v)
+		}
+		if r.Header.Get("x-ncp-iam-access-key") != "access" || r.Header.Get("x-ncp-apigw-signature-v1") == "" {
+			t.Errorf("Expected signed request, Actual headers: %v", r.Header)
+		}
+		w.WriteHeader(http.StatusOK)
+		w.Write([]byte(`{"uuid":"uuid-1"}`))
+	})
+
+	if err := client.ClustersUuidUpgradePatch(context.Background(), ncloud.String("uuid-1"), ncloud.String("1.21.9-nks.1")); err != nil {
+		t.Fatalf("Unexpected error: %s", err)
+	}
+}
+
+func TestNKSExtAPIClient_ClustersUuidNodePoolInstanceNoUpgradePatch(t *testing.T) {
+	client := testNKSExtAPIClient(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/vnks/v2/clusters/uuid-1/node-pool/123/upgrade" {
+			t.Errorf("Expected path: /vnks/v2/clusters/uuid-1/node-pool/123/upgrade, Actual: %s", r.URL.Path)
+		}
+		w.WriteHeader(http.StatusOK)
+	})
+
+	if err := client.ClustersUuidNodePoolInstanceNoUpgradePatch(context.Background(), ncloud.String("uuid-1"), ncloud.String("123"), ncloud.String("1.21.9-nks.1")); err != nil {
+		t.Fatalf("Unexpected error: %s", err)
+	}
+}
+
+func TestNKSExtAPIClient_errorStatus(t *testing.T) {
+	client := testNKSExtAPIClient(t, func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusBadRequest)
+		w.Write([]byte(`{"error":{"errorCode":"400","message":"invalid version"}}`))
+	})
+
+	if err := client.ClustersUuidUpgradePatch(context.Background(), ncloud.String("uuid-1"), ncloud.String("0.0")); err == nil {
+		t.Fatal("Expected error for status 400")
+	}
+}
+
+func TestNKSExtAPIClient_ClustersUuidNodePoolGet(t *testing.T) {
+	client := testNKSExtAPIClient(t, func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+		w.Write([]byte(`{"nodePool":[{"instanceNo":123,"name":"pool","status":"RUN","labels":[{"key":"role","value":"gpu"}],"taints":[{"key":"dedicated","value":"gpu","effect":"NoSchedule"}]}]}`))
+	})
+
+	resp, err := client.ClustersUuidNodePoolGet(context.Background(), ncloud.String("uuid-1"))
+	if err != nil {
+		t.Fatalf("Unexpected error: %s", err)
+	}
+
+	if len(resp.NodePool) != 1 {
+		t.Fatalf("Expected 1 node pool, Actual: %d", len(resp.NodePool))
+	}
+
+	np := resp.NodePool[0]
+	if ncloud.StringValue(np.Name) != "pool" || ncloud.Int32Value(np.InstanceNo) != 123 {
+		t.Fatalf("Expected node pool pool(123), Actual: %s(%d)", ncloud.StringValue(np.Name), ncloud.Int32Value(np.InstanceNo))
+	}
+
+	if len(np.Labels) != 1 || ncloud.StringValue(np.Labels[0].Value) != "gpu" {
+		t.Fatalf("Expected label role=gpu, Actual: %v", np.Labels)
+	}
+
+	if len(np.Taints) != 1 || ncloud.StringValue(np.Taints[0].Effect) != "NoSchedule" {
+		t.Fatalf("Expected taint effect NoSchedule, Actual: %v", np.Taints)
+	}
+}
+
+func TestIsNKSNotSupportedError(t *testing.T) {
+	cases := map[int]bool{
+		http.StatusNotFound:       true,
+		http.StatusNotImplemented: true,
+		http.StatusForbidden:      false,
+		http.StatusBadRequest:     false,
+	}
+
+	for status, expected := range cases {
+		client := testNKSExtAPIClient(t, func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(status)
+			w.Write([]byte(`{"error":{"errorCode":"300","message":"error"}}`))
+		})
+		client.cfg.HTTPClient = &http.Client{Transport: &apiErrorTransport{transport: http.DefaultTransport}}
+
+		_, err := client.ClustersUuidOidcGet(context.Background(), ncloud.String("uuid-1"))
+		if err == nil {
+			t.Fatalf("Expected error for status %d", status)
+		}
+		if actual := isNKSNotSupportedError(err); actual != expected {
+			t.Fatalf("Expected: %t for status %d, Actual: %t", expected, status, actual)
+		}
+	}
+}
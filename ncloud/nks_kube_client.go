@@ -0,0 +1,362 @@
+package ncloud
+
+import (
+	"bytes"
+	"context"
+	"crypto/tls"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"log"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+const (
+	kubeEvictionAPIVersion       = "policy/v1"
+	kubeLegacyEvictionAPIVersion = "policy/v1beta1"
+	kubeMirrorPodAnnotation      = "kubernetes.io/config.mirror"
+)
+
+// nksKubeClient minimal Kubernetes API client for the operations the provider runs against NKS clusters
+type nksKubeClient struct {
+	host       string
+	httpClient *http.Client
+}
+
+type kubeObjectMeta struct {
+	Name            string            `json:"name"`
+	Namespace       string            `json:"namespace,omitempty"`
+	Annotations     map[string]string `json:"annotations,omitempty"`
+	OwnerReferences []struct {
+		Kind string `json:"kind"`
+	} `json:"ownerReferences,omitempty"`
+}
+
+type kubePod struct {
+	Metadata kubeObjectMeta `json:"metadata"`
+	Status   struct {
+		Phase string `json:"phase"`
+	} `json:"status"`
+}
+
+type kubePodList struct {
+	Items []*kubePod `json:"items"`
+}
+
+type kubeNodeCondition struct {
+	Type   string `json:"type"`
+	Status string `json:"status"`
+}
+
+type kubeNode struct {
+	Metadata kubeObjectMeta `json:"metadata"`
+	Status   struct {
+		Conditions []*kubeNodeCondition `json:"conditions"`
+	} `json:"status"`
+}
+
+type kubeNodeList struct {
+	Items []*kubeNode `json:"items"`
+}
+
+// newNKSKubeClient returns Kubernetes API client of the cluster in kubeconfig.
+// newTransport builds the transport trusting the given CA, so that the client follows the TLS and proxy settings of the provider.
+func newNKSKubeClient(kc *KubeConfig, newTransport func(certificateAuthority []byte) (*http.Transport, error)) (*nksKubeClient, error) {
+	if kc == nil || len(kc.Clusters) == 0 {
+		return nil, fmt.Errorf("kubeconfig has no cluster")
+	}
+
+	var caPem []byte
+	if ca := kc.Clusters[0].Cluster.ClusterCaCertificate; ca != "" {
+		var err error
+		caPem, err = base64.StdEncoding.DecodeString(ca)
+		if err != nil {
+			return nil, fmt.Errorf("error decoding certificate-authority-data: %s", err)
+		}
+	}
+
+	transport, err := newTransport(caPem)
+	if err != nil {
+		return nil, fmt.Errorf("error building transport of kubernetes api: %s", err)
+	}
+
+	if len(kc.Users) > 0 && kc.Users[0].User.ClientCertificateData != "" {
+		certPem, err := base64.StdEncoding.DecodeString(kc.Users[0].User.ClientCertificateData)
+		if err != nil {
+			return nil, fmt.Errorf("error decoding client-certificate-data: %s", err)
+		}
+		keyPem, err := base64.StdEncoding.DecodeString(kc.Users[0].User.ClientKeyData)
+		if err != nil {
+			return nil, fmt.Errorf("error decoding client-key-data: %s", err)
+		}
+		cert, err := tls.X509KeyPair(certPem, keyPem)
+		if err != nil {
+			return nil, fmt.Errorf("error parsing client certificate: %s", err)
+		}
+		transport.TLSClientConfig.Certificates = []tls.Certificate{cert}
+	}
+
+	return &nksKubeClient{
+		host: strings.TrimSuffix(kc.Clusters[0].Cluster.Server, "/"),
+		httpClient: &http.Client{
+			Timeout:   30 * time.Second,
+			Transport: transport,
+		},
+	}, nil
+}
+
+// getNKSKubeClient returns Kubernetes API client of the cluster using the kubeconfig issued by NKS
+func getNKSKubeClient(ctx context.Context, config *ProviderConfig, uuid string) (*nksKubeClient, error) {
+	kc, err := getNKSKubeConfig(ctx, config, uuid)
+	if err != nil {
+		return nil, err
+	}
+	return newNKSKubeClient(kc, config.Client.transport)
+}
+
+// CordonNode marks the node as unschedulable
+func (c *nksKubeClient) CordonNode(ctx context.Context, nodeName string) error {
+	return c.setNodeUnschedulable(ctx, nodeName, true)
+}
+
+// UncordonNode marks the node as schedulable again
+func (c *nksKubeClient) UncordonNode(ctx context.Context, nodeName string) error {
+	return c.setNodeUnschedulable(ctx, nodeName, false)
+}
+
+func (c *nksKubeClient) setNodeUnschedulable(ctx context.Context, nodeName string, unschedulable bool) error {
+	body := map[string]interface{}{
+		"spec": map[string]interface{}{
+			"unschedulable": unschedulable,
+		},
+	}
+	_, err := c.call(ctx, http.MethodPatch, "/api/v1/nodes/"+url.PathEscape(nodeName), "application/strategic-merge-patch+json", body, nil)
+	return err
+}
+
+// DrainNodes cordons all the nodes first, so that the evicted pods are not scheduled on the nodes drained next, and drains them.
+// If any node fails, the nodes are uncordoned so that they stay in service.
+func (c *nksKubeClient) DrainNodes(ctx context.Context, nodeNames []string, timeout time.Duration) error {
+	err := c.drainNodes(ctx, nodeNames, timeout)
+	if err == nil {
+		return nil
+	}
+
+	for _, nodeName := range nodeNames {
+		if err := c.UncordonNode(ctx, nodeName); err != nil {
+			log.Printf("[WARN] Error uncordoning node (%s): %s", nodeName, err)
+		}
+	}
+	return err
+}
+
+func (c *nksKubeClient) drainNodes(ctx context.Context, nodeNames []string, timeout time.Duration) error {
+	for _, nodeName := range nodeNames {
+		if err := c.CordonNode(ctx, nodeName); err != nil {
+			return err
+		}
+	}
+
+	for _, nodeName := range nodeNames {
+		log.Printf("[INFO] Draining node (%s)", nodeName)
+		if err := c.DrainNode(ctx, nodeName, timeout); err != nil {
+			return fmt.Errorf("error draining node (%s): %s", nodeName, err)
+		}
+	}
+	return nil
+}
+
+// ListNodes returns all nodes of the cluster with the status code of the response
+func (c *nksKubeClient) ListNodes(ctx context.Context) ([]*kubeNode, int, error) {
+	nodes := &kubeNodeList{}
+	if status, err := c.call(ctx, http.MethodGet, "/api/v1/nodes", "", nil, nodes); err != nil {
+		return nil, status, err
+	}
+	return nodes.Items, http.StatusOK, nil
+}
+
+// WaitForNodesReady waits until the expected number of nodes are Ready.
+// nodeNames returns names of the nodes to count on every poll since nodes join the cluster one by one.
+// Transient errors of the lookup and the Kubernetes API are retried until timeout, since the API server may not be reachable yet while nodes join.
+func (c *nksKubeClient) WaitForNodesReady(ctx context.Context, timeout time.Duration, expected int, nodeNames func() ([]string, error)) error {
+	return resource.RetryContext(ctx, timeout, func() *resource.RetryError {
+		names, err := nodeNames()
+		if err != nil {
+			if apiErr := asAPIError(err); apiErr != nil && !isTransientStatus(apiErr.StatusCode) {
+				return resource.NonRetryableError(err)
+			}
+			return resource.RetryableError(err)
+		}
+
+		nodes, status, err := c.ListNodes(ctx)
+		if err != nil {
+			if status != 0 && !isTransientStatus(status) {
+				return resource.NonRetryableError(err)
+			}
+			return resource.RetryableError(err)
+		}
+
+		ready := 0
+		for _, node := range nodes {
+			if containsInStringList(node.Metadata.Name, names) && isKubeNodeReady(node) {
+				ready++
+			}
+		}
+
+		if ready < expected {
+			return resource.RetryableError(fmt.Errorf("waiting for nodes to be Ready: %d/%d", ready, expected))
+		}
+		return nil
+	})
+}
+
+// DrainNode evicts the pods running on the node and waits until they are gone.
+// DaemonSet and mirror pods are ignored like `kubectl drain --ignore-daemonsets` does.
+func (c *nksKubeClient) DrainNode(ctx context.Context, nodeName string, timeout time.Duration) error {
+	if err := c.CordonNode(ctx, nodeName); err != nil {
+		return err
+	}
+
+	return resource.RetryContext(ctx, timeout, func() *resource.RetryError {
+		pods, err := c.listEvictablePods(ctx, nodeName)
+		if err != nil {
+			return resource.NonRetryableError(err)
+		}
+
+		if len(pods) == 0 {
+			return nil
+		}
+
+		for _, pod := range pods {
+			if err := c.evictPod(ctx, pod); err != nil {
+				return resource.NonRetryableError(err)
+			}
+		}
+
+		return resource.RetryableError(fmt.Errorf("waiting for %d pods to be evicted from node (%s)", len(pods), nodeName))
+	})
+}
+
+func (c *nksKubeClient) listEvictablePods(ctx context.Context, nodeName string) ([]*kubePod, error) {
+	query := url.Values{}
+	query.Set("fieldSelector", "spec.nodeName="+nodeName)
+
+	pods := &kubePodList{}
+	if _, err := c.call(ctx, http.MethodGet, "/api/v1/pods?"+query.Encode(), "", nil, pods); err != nil {
+		return nil, err
+	}
+
+	var res []*kubePod
+	for _, pod := range pods.Items {
+		if pod.Status.Phase == "Succeeded" || pod.Status.Phase == "Failed" {
+			continue
+		}
+		if _, ok := pod.Metadata.Annotations[kubeMirrorPodAnnotation]; ok {
+			continue
+		}
+		if isDaemonSetPod(pod) {
+			continue
+		}
+		res = append(res, pod)
+	}
+	return res, nil
+}
+
+func (c *nksKubeClient) evictPod(ctx context.Context, pod *kubePod) error {
+	path := fmt.Sprintf("/api/v1/namespaces/%s/pods/%s/eviction", url.PathEscape(pod.Metadata.Namespace), url.PathEscape(pod.Metadata.Name))
+
+	for _, apiVersion := range []string{kubeEvictionAPIVersion, kubeLegacyEvictionAPIVersion} {
+		body := map[string]interface{}{
+			"apiVersion": apiVersion,
+			"kind":       "Eviction",
+			"metadata": map[string]string{
+				"name":      pod.Metadata.Name,
+				"namespace": pod.Metadata.Namespace,
+			},
+		}
+
+		status, err := c.call(ctx, http.MethodPost, path, "application/json", body, nil)
+		switch {
+		case err == nil, status == http.StatusNotFound && apiVersion == kubeLegacyEvictionAPIVersion:
+			return nil
+		case status == http.StatusTooManyRequests:
+			// Eviction is blocked by PodDisruptionBudget, it will be retried on next round
+			log.Printf("[DEBUG] eviction of pod %s/%s is blocked: %s", pod.Metadata.Namespace, pod.Metadata.Name, err)
+			return nil
+		case status == http.StatusNotFound, status == http.StatusBadRequest:
+			continue
+		default:
+			return err
+		}
+	}
+	return nil
+}
+
+func (c *nksKubeClient) call(ctx context.Context, method string, path string, contentType string, body interface{}, result interface{}) (int, error) {
+	var reqBody *bytes.Buffer
+	if body != nil {
+		b, err := json.Marshal(body)
+		if err != nil {
+			return 0, err
+		}
+		reqBody = bytes.NewBuffer(b)
+	} else {
+		reqBody = &bytes.Buffer{}
+	}
+
+	req, err := http.NewRequestWithContext(ctx, method, c.host+path, reqBody)
+	if err != nil {
+		return 0, err
+	}
+	req.Header.Set("Accept", "application/json")
+	if contentType != "" {
+		req.Header.Set("Content-Type", contentType)
+	}
+
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return 0, err
+	}
+	defer resp.Body.Close()
+	respBody, _ := ioutil.ReadAll(resp.Body)
+
+	if resp.StatusCode >= 300 {
+		return resp.StatusCode, fmt.Errorf("kubernetes api %s %s: Status: %v, Body: %s", method, path, resp.Status, respBody)
+	}
+
+	if result != nil {
+		if err := json.Unmarshal(respBody, result); err != nil {
+			return resp.StatusCode, err
+		}
+	}
+	return resp.StatusCode, nil
+}
+
+// isTransientStatus status code of the response worth retrying. 0 means no response is received.
+func isTransientStatus(status int) bool {
+	return status == 0 || status == http.StatusTooManyRequests || status >= http.StatusInternalServerError
+}
+
+func isKubeNodeReady(node *kubeNode) bool {
+	for _, condition := range node.Status.Conditions {
+		if condition.Type == "Ready" {
+			return condition.Status == "True"
+		}
+	}
+	return false
+}
+
+func isDaemonSetPod(pod *kubePod) bool {
+	for _, ref := range pod.Metadata.OwnerReferences {
+		if ref.Kind == "DaemonSet" {
+			return true
+		}
+	}
+	return false
+}
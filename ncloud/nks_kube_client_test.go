@@ -0,0 +1,285 @@
+package ncloud
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"gopkg.in/yaml.v3"
+)
+
+// testKubeAPIServer fake Kubernetes API server keeping pods per node
+type testKubeAPIServer struct {
+	sync.Mutex
+	cordoned map[string]bool
+	pods     map[string][]*kubePod
+	evicted  []string
+	// blocked pods of which the eviction fails
+	blocked map[string]bool
+}
+
+func (s *testKubeAPIServer) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	s.Lock()
+	defer s.Unlock()
+
+	switch {
+	case r.Method == http.MethodPatch && strings.HasPrefix(r.URL.Path, "/api/v1/nodes/"):
+		var body struct {
+			Spec struct {
+				Unschedulable bool `json:"unschedulable"`
+			} `json:"spec"`
+		}
+		json.NewDecoder(r.Body).Decode(&body)
+		s.cordoned[strings.TrimPrefix(r.URL.Path, "/api/v1/nodes/")] = body.Spec.Unschedulable
+		w.Write([]byte(`{}`))
+	case r.Method == http.MethodGet && r.URL.Path == "/api/v1/pods":
+		nodeName := strings.TrimPrefix(r.URL.Query().Get("fieldSelector"), "spec.nodeName=")
+		json.NewEncoder(w).Encode(&kubePodList{Items: s.pods[nodeName]})
+	case r.Method == http.MethodPost && strings.HasSuffix(r.URL.Path, "/eviction"):
+		name := strings.Split(r.URL.Path, "/")[6]
+		if s.blocked[name] {
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+		for node, pods := range s.pods {
+			var remain []*kubePod
+			for _, pod := range pods {
+				if pod.Metadata.Name != name {
+					remain = append(remain, pod)
+				}
+			}
+			s.pods[node] = remain
+		}
+		s.evicted = append(s.evicted, name)
+		w.WriteHeader(http.StatusCreated)
+		w.Write([]byte(`{}`))
+	default:
+		w.WriteHeader(http.StatusNotFound)
+	}
+}
+
+func testKubePod(name string, ownerKind string) *kubePod {
+	pod := &kubePod{}
+	pod.Metadata.Name = name
+	pod.Metadata.Namespace = "default"
+	pod.Status.Phase = "Running"
+	if ownerKind != "" {
+		pod.Metadata.OwnerReferences = append(pod.Metadata.OwnerReferences, struct {
+			Kind string `json:"kind"`
+		}{Kind: ownerKind})
+	}
+	return pod
+}
+
+func TestNKSKubeClient_DrainNode(t *testing.T) {
+	fake := &testKubeAPIServer{
+		cordoned: map[string]bool{},
+		pods: map[string][]*kubePod{
+			"node-1": {
+				testKubePod("web-1", "ReplicaSet"),
+				testKubePod("fluent-bit-1", "DaemonSet"),
+			},
+		},
+	}
+	ts := httptest.NewServer(fake)
+	defer ts.Close()
+
+	client := &nksKubeClient{host: ts.URL, httpClient: ts.Client()}
+
+	if err := client.DrainNode(context.Background(), "node-1", time.Minute); err != nil {
+		t.Fatalf("Unexpected error: %s", err)
+	}
+
+	if !fake.cordoned["node-1"] {
+		t.Fatal("Expected node-1 to be cordoned")
+	}
+
+	if len(fake.evicted) != 1 || fake.evicted[0] != "web-1" {
+		t.Fatalf("Expected only web-1 to be evicted, Actual: %v", fake.evicted)
+	}
+}
+
+func TestNKSKubeClient_DrainNodesError(t *testing.T) {
+	fake := &testKubeAPIServer{
+		cordoned: map[string]bool{},
+		pods: map[string][]*kubePod{
+			"node-1": {testKubePod("web-1", "ReplicaSet")},
+			"node-2": {testKubePod("web-2", "ReplicaSet")},
+		},
+		blocked: map[string]bool{"web-2": true},
+	}
+	ts := httptest.NewServer(fake)
+	defer ts.Close()
+
+	client := &nksKubeClient{host: ts.URL, httpClient: ts.Client()}
+
+	if err := client.DrainNodes(context.Background(), []string{"node-1", "node-2"}, time.Minute); err == nil {
+		t.Fatal("Expected error for the eviction failed")
+	}
+
+	// The nodes stay in service after the failed drain
+	for _, node := range []string{"node-1", "node-2"} {
+		if cordoned, ok := fake.cordoned[node]; !ok || cordoned {
+			t.Fatalf("Expected %s to be uncordoned, Actual: %v", node, fake.cordoned)
+		}
+	}
+}
+
+func TestNKSKubeClient_CordonNodeError(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusForbidden)
+	}))
+	defer ts.Close()
+
+	client := &nksKubeClient{host: ts.URL, httpClient: ts.Client()}
+
+	if err := client.CordonNode(context.Background(), "node-1"); err == nil {
+		t.Fatal("Expected error for status 403")
+	}
+}
+
+func testKubeNode(name string, ready bool) *kubeNode {
+	node := &kubeNode{}
+	node.Metadata.Name = name
+	status := "False"
+	if ready {
+		status = "True"
+	}
+	node.Status.Conditions = []*kubeNodeCondition{{Type: "MemoryPressure", Status: "False"}, {Type: "Ready", Status: status}}
+	return node
+}
+
+func TestNKSKubeClient_WaitForNodesReady(t *testing.T) {
+	var mu sync.Mutex
+	polls := 0
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodGet || r.URL.Path != "/api/v1/nodes" {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+
+		mu.Lock()
+		defer mu.Unlock()
+		polls++
+
+		// node-2 joins on the second poll, other-pool is Ready but must not be counted
+		nodes := &kubeNodeList{Items: []*kubeNode{testKubeNode("node-1", true), testKubeNode("other-pool", true)}}
+		if polls >= 2 {
+			nodes.Items = append(nodes.Items, testKubeNode("node-2", polls >= 3))
+		}
+		json.NewEncoder(w).Encode(nodes)
+	}))
+	defer ts.Close()
+
+	client := &nksKubeClient{host: ts.URL, httpClient: ts.Client()}
+	nodeNames := func() ([]string, error) {
+		return []string{"node-1", "node-2"}, nil
+	}
+
+	if err := client.WaitForNodesReady(context.Background(), time.Minute, 2, nodeNames); err != nil {
+		t.Fatalf("Unexpected error: %s", err)
+	}
+
+	if polls < 3 {
+		t.Fatalf("Expected to poll until node-2 is Ready, Actual polls: %d", polls)
+	}
+}
+
+func TestNKSKubeClient_WaitForNodesReadyTimeout(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		json.NewEncoder(w).Encode(&kubeNodeList{Items: []*kubeNode{testKubeNode("node-1", false)}})
+	}))
+	defer ts.Close()
+
+	client := &nksKubeClient{host: ts.URL, httpClient: ts.Client()}
+	nodeNames := func() ([]string, error) {
+		return []string{"node-1"}, nil
+	}
+
+	if err := client.WaitForNodesReady(context.Background(), time.Second, 1, nodeNames); err == nil {
+		t.Fatal("Expected timeout error for NotReady node")
+	}
+}
+
+func TestNKSKubeClient_WaitForNodesReadyTransientError(t *testing.T) {
+	polls := 0
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		polls++
+		if polls == 1 {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+		json.NewEncoder(w).Encode(&kubeNodeList{Items: []*kubeNode{testKubeNode("node-1", true)}})
+	}))
+	defer ts.Close()
+
+	client := &nksKubeClient{host: ts.URL, httpClient: ts.Client()}
+	nodeNames := func() ([]string, error) {
+		return []string{"node-1"}, nil
+	}
+
+	if err := client.WaitForNodesReady(context.Background(), time.Minute, 1, nodeNames); err != nil {
+		t.Fatalf("Unexpected error: %s", err)
+	}
+}
+
+func TestNKSKubeClient_WaitForNodesReadyForbidden(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusForbidden)
+	}))
+	defer ts.Close()
+
+	client := &nksKubeClient{host: ts.URL, httpClient: ts.Client()}
+	nodeNames := func() ([]string, error) {
+		return []string{"node-1"}, nil
+	}
+
+	if err := client.WaitForNodesReady(context.Background(), time.Minute, 1, nodeNames); err == nil || !strings.Contains(err.Error(), "403") {
+		t.Fatalf("Expected error for status 403, Actual: %v", err)
+	}
+}
+
+func TestNewNKSKubeClient_httpProxy(t *testing.T) {
+	var proxied string
+	proxy := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		proxied = r.URL.String()
+		w.Write([]byte(`{"items":[]}`))
+	}))
+	defer proxy.Close()
+
+	kc := &KubeConfig{}
+	if err := yaml.Unmarshal([]byte("clusters:\n- cluster:\n    server: http://kube.example.com/\n"), kc); err != nil {
+		t.Fatal(err)
+	}
+
+	client, err := newNKSKubeClient(kc, (&Config{HTTPProxy: proxy.URL}).transport)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if _, _, err := client.ListNodes(context.Background()); err != nil {
+		t.Fatalf("Unexpected error: %s", err)
+	}
+
+	if expected := "http://kube.example.com/api/v1/nodes"; proxied != expected {
+		t.Fatalf("Expected request through proxy: %s, Actual: %s", expected, proxied)
+	}
+}
+
+func TestNKSSurgeNodePoolName(t *testing.T) {
+	cases := map[string]string{
+		"pool":                           "pool-surge",
+		"abcdefghijklmnopqrstuvwxyz1234": "abcdefghijklmnopqrstuvwx-surge",
+	}
+
+	for name, expected := range cases {
+		if actual := nksSurgeNodePoolName(name); actual != expected {
+			t.Fatalf("Expected: %s, Actual: %s", expected, actual)
+		}
+	}
+}
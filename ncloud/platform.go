@@ -0,0 +1,65 @@
+package ncloud
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+const (
+	PlatformClassic = "classic"
+	PlatformVpc     = "vpc"
+)
+
+func platformName(supportVPC bool) string {
+	if supportVPC {
+		return PlatformVpc
+	}
+	return PlatformClassic
+}
+
+// platformSchema `platform` of resources supporting both Classic and VPC, overriding `support_vpc` of the provider
+func platformSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:             schema.TypeString,
+		Optional:         true,
+		ForceNew:         true,
+		ValidateDiagFunc: ToDiagFunc(validation.StringInSlice([]string{PlatformClassic, PlatformVpc}, false)),
+		Description:      "Platform of the resource, `classic` or `vpc`. Default `support_vpc` of the provider",
+	}
+}
+
+// resourceData is satisfied by both *schema.ResourceData and *schema.ResourceDiff
+type resourceData interface {
+	Get(key string) interface{}
+}
+
+// resourcePlatformConfig returns the provider config of `platform` of the resource
+func resourcePlatformConfig(d resourceData, meta interface{}) (*ProviderConfig, error) {
+	return meta.(*ProviderConfig).forPlatform(d.Get("platform").(string))
+}
+
+// newPlatformConfig returns a copy of the config for the other platform, sharing the client and region of the provider
+func (c *ProviderConfig) newPlatformConfig(supportVPC bool, newCache func(supportVPC bool) *regionCache) *ProviderConfig {
+	pc := *c
+	pc.SupportVPC = supportVPC
+	pc.regionCache = newCache(supportVPC)
+	pc.platformConfigs = nil
+	pc.setPlatformServices()
+
+	return &pc
+}
+
+// forPlatform returns the config of the platform. An empty platform returns the config of the provider.
+func (c *ProviderConfig) forPlatform(platform string) (*ProviderConfig, error) {
+	if platform == "" || platform == platformName(c.SupportVPC) {
+		return c, nil
+	}
+
+	if pc, ok := c.platformConfigs[platform]; ok {
+		return pc, nil
+	}
+
+	return nil, fmt.Errorf("platform `%s` is not supported on site `%s`", platform, c.Site)
+}
@@ -0,0 +1,76 @@
+package ncloud
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func testPlatformProviderConfig(t *testing.T, raw map[string]interface{}) *ProviderConfig {
+	raw["access_key"] = "access"
+	raw["secret_key"] = "secret"
+	raw["region"] = "KR"
+
+	p := Provider()
+	if diags := p.Configure(context.Background(), terraform.NewResourceConfigRaw(raw)); diags.HasError() {
+		t.Fatalf("Unexpected error: %v", diags)
+	}
+
+	return p.Meta().(*ProviderConfig)
+}
+
+func TestResourcePlatformConfig(t *testing.T) {
+	config := testPlatformProviderConfig(t, map[string]interface{}{"support_vpc": false})
+	resourceSchema := resourceNcloudPublicIpInstance().Schema
+
+	cases := []struct {
+		platform   string
+		supportVPC bool
+	}{
+		{"", false},
+		{PlatformClassic, false},
+		{PlatformVpc, true},
+	}
+
+	for _, tc := range cases {
+		d := schema.TestResourceDataRaw(t, resourceSchema, map[string]interface{}{"platform": tc.platform})
+
+		actual, err := resourcePlatformConfig(d, config)
+		if err != nil {
+			t.Fatalf("Unexpected error: %s", err)
+		}
+
+		if actual.SupportVPC != tc.supportVPC {
+			t.Fatalf("Expected: %t, Actual: %t (platform: %s)", tc.supportVPC, actual.SupportVPC, tc.platform)
+		}
+
+		if tc.platform != PlatformVpc && actual != config {
+			t.Fatalf("Expected the provider config for platform `%s`", tc.platform)
+		}
+	}
+
+	vpc, _ := config.forPlatform(PlatformVpc)
+	if _, ok := vpc.PublicIpService.(*vpcPublicIpService); !ok {
+		t.Fatalf("Expected: vpcPublicIpService, Actual: %T", vpc.PublicIpService)
+	}
+	if vpc.regionCache == config.regionCache || !vpc.regionCache.supportVPC {
+		t.Fatal("Expected region cache of VPC for platform `vpc`")
+	}
+	if vpc.Client != config.Client || vpc.RegionCode != config.RegionCode {
+		t.Fatal("Expected client and region of the provider for platform `vpc`")
+	}
+}
+
+func TestResourcePlatformConfig_fin(t *testing.T) {
+	config := testPlatformProviderConfig(t, map[string]interface{}{"site": "fin"})
+
+	if _, err := config.forPlatform(PlatformVpc); err != nil {
+		t.Fatalf("Unexpected error: %s", err)
+	}
+
+	if _, err := config.forPlatform(PlatformClassic); err == nil {
+		t.Fatal("Expected error for platform `classic` on fin")
+	}
+}
@@ -3,14 +3,21 @@ package ncloud
 import (
 	"fmt"
 	"os"
+	"path/filepath"
+	"time"
 
+	"github.com/NaverCloudPlatform/ncloud-sdk-go-v2/ncloud/credentials"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 )
 
 var NcloudResources map[string]*schema.Resource
 var NcloudDataSources map[string]*schema.Resource
 
 func Provider() *schema.Provider {
+	registerSensitiveLogKeys(DataSourcesMap())
+	registerSensitiveLogKeys(ResourcesMap())
+
 	return &schema.Provider{
 		Schema:         schemaMap(),
 		DataSourcesMap: DataSourcesMap(),
@@ -23,16 +30,30 @@ func schemaMap() map[string]*schema.Schema {
 	return map[string]*schema.Schema{
 		"access_key": {
 			Type:        schema.TypeString,
-			Required:    true,
-			DefaultFunc: schema.EnvDefaultFunc("NCLOUD_ACCESS_KEY", nil),
+			Optional:    true,
 			Description: descriptions["access_key"],
 		},
 		"secret_key": {
 			Type:        schema.TypeString,
-			Required:    true,
-			DefaultFunc: schema.EnvDefaultFunc("NCLOUD_SECRET_KEY", nil),
+			Optional:    true,
 			Description: descriptions["secret_key"],
 		},
+		"profile": {
+			Type:        schema.TypeString,
+			Optional:    true,
+			Description: descriptions["profile"],
+		},
+		"credentials_file": {
+			Type:        schema.TypeString,
+			Optional:    true,
+			DefaultFunc: schema.EnvDefaultFunc("NCLOUD_CREDENTIALS_FILE", nil),
+			Description: descriptions["credentials_file"],
+		},
+		"credential_process": {
+			Type:        schema.TypeString,
+			Optional:    true,
+			Description: descriptions["credential_process"],
+		},
 		"region": {
 			Type:        schema.TypeString,
 			Required:    true,
@@ -51,24 +72,120 @@ func schemaMap() map[string]*schema.Schema {
 			DefaultFunc: schema.EnvDefaultFunc("NCLOUD_SUPPORT_VPC", nil),
 			Description: descriptions["support_vpc"],
 		},
+		"endpoints": endpointsSchema(),
+		"insecure": {
+			Type:        schema.TypeBool,
+			Optional:    true,
+			Default:     false,
+			Description: descriptions["insecure"],
+		},
+		"ca_bundle": {
+			Type:        schema.TypeString,
+			Optional:    true,
+			DefaultFunc: schema.EnvDefaultFunc("NCLOUD_CA_BUNDLE", nil),
+			Description: descriptions["ca_bundle"],
+		},
+		"http_proxy": {
+			Type:        schema.TypeString,
+			Optional:    true,
+			Description: descriptions["http_proxy"],
+		},
+		"max_retries": {
+			Type:             schema.TypeInt,
+			Optional:         true,
+			Default:          DefaultMaxRetries,
+			ValidateDiagFunc: ToDiagFunc(validation.IntAtLeast(0)),
+			Description:      descriptions["max_retries"],
+		},
+		"api_rate_limit": {
+			Type:             schema.TypeFloat,
+			Optional:         true,
+			ValidateDiagFunc: ToDiagFunc(validation.FloatAtLeast(0)),
+			Description:      descriptions["api_rate_limit"],
+		},
+		"api_rate_limit_burst": {
+			Type:             schema.TypeInt,
+			Optional:         true,
+			ValidateDiagFunc: ToDiagFunc(validation.IntAtLeast(0)),
+			Description:      descriptions["api_rate_limit_burst"],
+		},
+		"metadata_cache_ttl": {
+			Type:             schema.TypeString,
+			Optional:         true,
+			Default:          "0s",
+			ValidateDiagFunc: ToDiagFunc(validateParseDuration),
+			Description:      descriptions["metadata_cache_ttl"],
+		},
+		"metadata_cache_dir": {
+			Type:        schema.TypeString,
+			Optional:    true,
+			Description: descriptions["metadata_cache_dir"],
+		},
+		"default_tags": {
+			Type:        schema.TypeList,
+			Optional:    true,
+			MaxItems:    1,
+			Description: descriptions["default_tags"],
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"tags": {
+						Type:     schema.TypeMap,
+						Optional: true,
+						Elem:     &schema.Schema{Type: schema.TypeString},
+					},
+				},
+			},
+		},
+	}
+}
+
+func endpointsSchema() *schema.Schema {
+	endpoints := map[string]*schema.Schema{}
+	for _, service := range EndpointServices {
+		endpoints[service] = &schema.Schema{
+			Type:             schema.TypeString,
+			Optional:         true,
+			ValidateDiagFunc: ToDiagFunc(validation.IsURLWithHTTPorHTTPS),
+			Description:      fmt.Sprintf("Base URL of %s API", service),
+		}
+	}
+
+	return &schema.Schema{
+		Type:        schema.TypeList,
+		Optional:    true,
+		MaxItems:    1,
+		Description: descriptions["endpoints"],
+		Elem: &schema.Resource{
+			Schema: endpoints,
+		},
+	}
+}
+
+func expandProviderEndpoints(l []interface{}) map[string]string {
+	endpoints := map[string]string{}
+
+	if len(l) == 0 || l[0] == nil {
+		return endpoints
+	}
+
+	for service, v := range l[0].(map[string]interface{}) {
+		if v.(string) != "" {
+			endpoints[service] = v.(string)
+		}
 	}
+
+	return endpoints
 }
 
 func providerConfigure(d *schema.ResourceData) (interface{}, error) {
 	providerConfig := ProviderConfig{
-		SupportVPC: d.Get("support_vpc").(bool),
+		SupportVPC:  d.Get("support_vpc").(bool),
+		DefaultTags: expandProviderDefaultTags(d.Get("default_tags").([]interface{})),
 	}
 
 	// Set site
 	if site, ok := d.GetOk("site"); ok {
 		providerConfig.Site = site.(string)
-
-		switch site {
-		case "gov":
-			os.Setenv("NCLOUD_API_GW", "https://ncloud.apigw.gov-ntruss.com")
-		case "fin":
-			os.Setenv("NCLOUD_API_GW", "https://fin-ncloud.apigw.fin-ntruss.com")
-		}
 	}
 
 	// Fin only supports VPC
@@ -76,32 +193,72 @@ func providerConfigure(d *schema.ResourceData) (interface{}, error) {
 		providerConfig.SupportVPC = true
 	}
 
+	// Set credentials
+	accessKey, secretKey, err := resolveCredentials(&CredentialsConfig{
+		AccessKey:         d.Get("access_key").(string),
+		SecretKey:         d.Get("secret_key").(string),
+		Profile:           d.Get("profile").(string),
+		CredentialsFile:   d.Get("credentials_file").(string),
+		CredentialProcess: d.Get("credential_process").(string),
+		EnvAccessKey:      os.Getenv("NCLOUD_ACCESS_KEY"),
+		EnvSecretKey:      os.Getenv("NCLOUD_SECRET_KEY"),
+		EnvProfile:        os.Getenv("NCLOUD_PROFILE"),
+	})
+	if err != nil {
+		return nil, err
+	}
+
 	// Set client
 	config := Config{
-		AccessKey: d.Get("access_key").(string),
-		SecretKey: d.Get("secret_key").(string),
-		Region:    d.Get("region").(string),
+		AccessKey:  accessKey,
+		SecretKey:  secretKey,
+		Region:     d.Get("region").(string),
+		Site:       providerConfig.Site,
+		ApiGateway: os.Getenv("NCLOUD_API_GW"),
+		Endpoints:  expandProviderEndpoints(d.Get("endpoints").([]interface{})),
+		Insecure:   d.Get("insecure").(bool),
+		CABundle:   d.Get("ca_bundle").(string),
+		HTTPProxy:  d.Get("http_proxy").(string),
+		MaxRetries: d.Get("max_retries").(int),
+
+		ApiRateLimit:      d.Get("api_rate_limit").(float64),
+		ApiRateLimitBurst: d.Get("api_rate_limit_burst").(int),
 	}
 
 	if client, err := config.Client(); err != nil {
 		return nil, err
 	} else {
 		providerConfig.Client = client
+		providerConfig.ApiGateway = config.apiGateway()
 	}
 
-	// Set region
-	if err := setRegionCache(providerConfig.Client, providerConfig.SupportVPC); err != nil {
-		return nil, err
+	// Set region. Region and zone metadata is loaded on first use, so configure works without network access.
+	providerConfig.RegionCode = config.Region
+
+	metadataCacheTtl, err := time.ParseDuration(d.Get("metadata_cache_ttl").(string))
+	if err != nil {
+		return nil, fmt.Errorf("error parsing metadata_cache_ttl: %s", err)
+	}
+
+	metadataCacheDir := d.Get("metadata_cache_dir").(string)
+	if metadataCacheDir == "" {
+		metadataCacheDir = filepath.Join(credentials.UserHomeDir(), ".ncloud", "cache")
 	}
 
-	if region, ok := d.GetOk("region"); ok && isValidRegionCode(region.(string)) {
-		os.Setenv("NCLOUD_REGION", region.(string))
-		providerConfig.RegionCode = region.(string)
-		if !providerConfig.SupportVPC {
-			providerConfig.RegionNo = *regionCacheByCode[region.(string)].RegionNo
+	endpoint := config.apiGateway() + config.Endpoints["server"] + config.Endpoints["vserver"]
+	newCache := func(supportVPC bool) *regionCache {
+		return newRegionCache(providerConfig.Client, supportVPC, config.Region, metadataCacheDir, metadataCacheTtl, endpoint)
+	}
+
+	providerConfig.regionCache = newCache(providerConfig.SupportVPC)
+	providerConfig.setPlatformServices()
+
+	// Resources can override the platform, except on fin which only supports VPC
+	if providerConfig.Site != "fin" {
+		other := providerConfig.newPlatformConfig(!providerConfig.SupportVPC, newCache)
+		providerConfig.platformConfigs = map[string]*ProviderConfig{
+			platformName(other.SupportVPC): other,
 		}
-	} else {
-		return nil, fmt.Errorf("no region data for region_code `%s`. please change region_code and try again", region)
 	}
 
 	return &providerConfig, nil
@@ -111,11 +268,24 @@ var descriptions map[string]string
 
 func init() {
 	descriptions = map[string]string{
-		"access_key":  "Access key of ncloud",
-		"secret_key":  "Secret key of ncloud",
-		"region":      "Region of ncloud",
-		"site":        "Site of ncloud (public / gov / fin)",
-		"support_vpc": "Support VPC platform",
+		"access_key":           "Access key of ncloud",
+		"secret_key":           "Secret key of ncloud",
+		"profile":              "Profile of the credentials file",
+		"credentials_file":     "Path of the credentials file. Default `~/.ncloud/configure`",
+		"credential_process":   "Command returning access key and secret key as JSON",
+		"region":               "Region of ncloud",
+		"site":                 "Site of ncloud (public / gov / fin)",
+		"support_vpc":          "Support VPC platform",
+		"endpoints":            "Base URL of each service API, e.g. a private gateway or a local mock API",
+		"insecure":             "Skip verification of TLS certificates of the API",
+		"ca_bundle":            "Path of PEM encoded CA certificates used to verify the API",
+		"http_proxy":           "URL of HTTP proxy used to call the API",
+		"max_retries":          "Maximum number of retries of API calls rejected with HTTP 429, a retryable error code, or 5xx for reads",
+		"api_rate_limit":       "Maximum number of API calls per second shared by all resources of the provider. Default `0` (unlimited)",
+		"api_rate_limit_burst": "Maximum number of API calls allowed at once above `api_rate_limit`",
+		"metadata_cache_ttl":   "How long region and zone metadata is kept in the disk cache, e.g. `24h`. Default `0s` (disabled)",
+		"metadata_cache_dir":   "Directory of the disk cache of region and zone metadata. Default `~/.ncloud/cache`",
+		"default_tags":         "Tags applied to `ncloud_server` on Classic, the only resource the API accepts tags for",
 	}
 }
 
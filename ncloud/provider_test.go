@@ -1,6 +1,7 @@
 package ncloud
 
 import (
+	"context"
 	"fmt"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
@@ -80,6 +81,32 @@ func TestProvider_impl(t *testing.T) {
 	var _ = Provider()
 }
 
+func TestProvider_configureOffline(t *testing.T) {
+	// region and zone metadata is loaded on first use, so configure does not call the API
+	raw := map[string]interface{}{
+		"access_key": "access",
+		"secret_key": "secret",
+		"region":     "KR",
+		"endpoints": []interface{}{map[string]interface{}{
+			"server": "http://127.0.0.1:1/server/v2",
+		}},
+	}
+
+	p := Provider()
+	if diags := p.Configure(context.Background(), terraform.NewResourceConfigRaw(raw)); diags.HasError() {
+		t.Fatalf("Unexpected error: %v", diags)
+	}
+
+	config := p.Meta().(*ProviderConfig)
+	if config.RegionCode != "KR" {
+		t.Fatalf("Expected: KR, Actual: %s", config.RegionCode)
+	}
+
+	if _, err := config.regionNo(); err == nil {
+		t.Fatal("Expected error loading region without network access")
+	}
+}
+
 func testAccPreCheck(t *testing.T) {
 	if v := multiEnvSearch(credsEnvVars); v == "" {
 		t.Fatalf("One of %s must be set for acceptance tests", strings.Join(credsEnvVars, ", "))
@@ -133,6 +160,10 @@ func testAccCheckResourceDisappears(provider *schema.Provider, resource *schema.
 			return fmt.Errorf("resource ID missing: %s", resourceName)
 		}
 
-		return resource.Delete(resource.Data(resourceState.Primary), provider.Meta())
+		if diags := resource.DeleteContext(context.Background(), resource.Data(resourceState.Primary), provider.Meta()); diags.HasError() {
+			return fmt.Errorf("error deleting %s: %v", resourceName, diags)
+		}
+
+		return nil
 	}
 }
@@ -0,0 +1,64 @@
+package ncloud
+
+import (
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// PublicIpService public IP API of the platform selected by `support_vpc` of the provider
+type PublicIpService interface {
+	// Create requests creation of the public IP from the resource data, as the parameters differ by platform
+	Create(d *schema.ResourceData) (*string, error)
+	// Get returns nil if the public IP is not found
+	Get(id string) (*PublicIpInstance, error)
+	Delete(id string) error
+	Associate(id string, serverInstanceNo string) error
+	Disassociate(id string) error
+}
+
+type classicPublicIpService struct {
+	config *ProviderConfig
+}
+
+func (s *classicPublicIpService) Create(d *schema.ResourceData) (*string, error) {
+	return createClassicPublicIp(d, s.config)
+}
+
+func (s *classicPublicIpService) Get(id string) (*PublicIpInstance, error) {
+	return getClassicPublicIp(s.config, id)
+}
+
+func (s *classicPublicIpService) Delete(id string) error {
+	return deleteClassicPublicIp(s.config, id)
+}
+
+func (s *classicPublicIpService) Associate(id string, serverInstanceNo string) error {
+	return associatedClassicPublicIp(s.config, id, serverInstanceNo)
+}
+
+func (s *classicPublicIpService) Disassociate(id string) error {
+	return disassociatedClassicPublicIp(s.config, id)
+}
+
+type vpcPublicIpService struct {
+	config *ProviderConfig
+}
+
+func (s *vpcPublicIpService) Create(d *schema.ResourceData) (*string, error) {
+	return createVpcPublicIp(d, s.config)
+}
+
+func (s *vpcPublicIpService) Get(id string) (*PublicIpInstance, error) {
+	return getVpcPublicIp(s.config, id)
+}
+
+func (s *vpcPublicIpService) Delete(id string) error {
+	return deleteVpcPublicIp(s.config, id)
+}
+
+func (s *vpcPublicIpService) Associate(id string, serverInstanceNo string) error {
+	return associatedVpcPublicIp(s.config, id, serverInstanceNo)
+}
+
+func (s *vpcPublicIpService) Disassociate(id string) error {
+	return disassociatedVpcPublicIp(s.config, id)
+}
@@ -0,0 +1,119 @@
+package ncloud
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/NaverCloudPlatform/ncloud-sdk-go-v2/services/server"
+	"github.com/NaverCloudPlatform/ncloud-sdk-go-v2/services/vserver"
+)
+
+func TestNewRateLimiter(t *testing.T) {
+	if limiter := newRateLimiter(0, 10); limiter != nil {
+		t.Fatal("Expected no limiter for rate 0")
+	}
+
+	if limiter := newRateLimiter(2.5, 0); limiter.burst != 3 {
+		t.Fatalf("Expected default burst: 3, Actual: %v", limiter.burst)
+	}
+}
+
+func TestRateLimiter_reserve(t *testing.T) {
+	now := time.Unix(0, 0)
+	limiter := newRateLimiter(2, 2)
+	limiter.last = now
+	limiter.now = func() time.Time { return now }
+
+	// burst
+	for i := 0; i < 2; i++ {
+		if delay := limiter.reserve(); delay != 0 {
+			t.Fatalf("Expected no delay within burst, Actual: %s", delay)
+		}
+	}
+
+	if delay := limiter.reserve(); delay != 500*time.Millisecond {
+		t.Fatalf("Expected delay: 500ms, Actual: %s", delay)
+	}
+
+	if delay := limiter.reserve(); delay != time.Second {
+		t.Fatalf("Expected delay: 1s, Actual: %s", delay)
+	}
+
+	// refilled up to burst only
+	now = now.Add(time.Minute)
+	for i := 0; i < 2; i++ {
+		if delay := limiter.reserve(); delay != 0 {
+			t.Fatalf("Expected no delay after refill, Actual: %s", delay)
+		}
+	}
+
+	if delay := limiter.reserve(); delay == 0 {
+		t.Fatal("Expected delay above burst after refill")
+	}
+}
+
+func TestRateLimiter_WaitCanceled(t *testing.T) {
+	limiter := newRateLimiter(0.001, 1)
+	limiter.Wait(context.Background())
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+
+	if err := limiter.Wait(ctx); err == nil {
+		t.Fatal("Expected error for canceled context")
+	}
+
+	if limiter.tokens < -0.01 {
+		t.Fatalf("Expected token of canceled wait to be returned, Actual tokens: %v", limiter.tokens)
+	}
+}
+
+func TestConfig_ClientApiRateLimit(t *testing.T) {
+	var calls int32
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&calls, 1)
+		w.Write([]byte(`{"getRegionListResponse": {"returnCode":"0","regionList":[{"regionNo":"1","regionCode":"KR"}]}}`))
+	}))
+	defer ts.Close()
+
+	client, err := (&Config{
+		AccessKey:         "access",
+		SecretKey:         "secret",
+		Region:            "KR",
+		Endpoints:         map[string]string{"server": ts.URL + "/server/v2", "vserver": ts.URL + "/vserver/v2"},
+		ApiRateLimit:      20,
+		ApiRateLimitBurst: 2,
+	}).Client()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// 6 calls over 2 services share the bucket: 2 at once, then 4 at 20/s
+	start := time.Now()
+	var wg sync.WaitGroup
+	for i := 0; i < 3; i++ {
+		wg.Add(2)
+		go func() {
+			defer wg.Done()
+			client.server.V2Api.GetRegionList(&server.GetRegionListRequest{})
+		}()
+		go func() {
+			defer wg.Done()
+			client.vserver.V2Api.GetRegionList(&vserver.GetRegionListRequest{})
+		}()
+	}
+	wg.Wait()
+
+	if elapsed := time.Since(start); elapsed < 180*time.Millisecond {
+		t.Fatalf("Expected calls to be limited to 20/s, Actual: %d calls in %s", calls, elapsed)
+	}
+
+	if calls != 6 {
+		t.Fatalf("Expected 6 calls, Actual: %d", calls)
+	}
+}
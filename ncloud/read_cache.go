@@ -0,0 +1,183 @@
+package ncloud
+
+import (
+	"net/http"
+	"sync/atomic"
+
+	"github.com/NaverCloudPlatform/ncloud-sdk-go-v2/ncloud"
+	"github.com/NaverCloudPlatform/ncloud-sdk-go-v2/services/vserver"
+)
+
+// readCache instances read on refresh, cached for the run of the provider.
+// Concurrent reads of resources are batched into list calls, e.g. one GetServerInstanceList of many servers instead of a call per server.
+// Any API call changing instances invalidates the cache. Waiters read the API directly, as they poll for changes.
+type readCache struct {
+	servers           *bulkReader[ServerInstance]
+	blockStorages     *bulkReader[BlockStorage]
+	publicIps         *bulkReader[PublicIpInstance]
+	networkInterfaces *bulkReader[vserver.NetworkInterface]
+}
+
+// newReadCache returns the cache of the platform of the config, reading through its services
+func newReadCache(c *ProviderConfig) *readCache {
+	var generation func() int64
+	if c.Client != nil && c.Client.mutations != nil {
+		generation = c.Client.mutations.value
+	}
+
+	return &readCache{
+		servers: &bulkReader[ServerInstance]{
+			List: func(ids []string) ([]*ServerInstance, error) {
+				if c.SupportVPC {
+					return getVpcServerInstanceList(c, ids)
+				}
+				return getClassicServerInstanceList(c, ids)
+			},
+			Lookup:     c.ServerService.Get,
+			ID:         serverInstanceNo,
+			Generation: generation,
+		},
+		blockStorages: &bulkReader[BlockStorage]{
+			List: func(ids []string) ([]*BlockStorage, error) {
+				if c.SupportVPC {
+					return getVpcBlockStorageInstanceList(c, ids)
+				}
+				return getClassicBlockStorageInstanceList(c, ids)
+			},
+			Lookup:     c.BlockStorageService.Get,
+			ID:         blockStorageInstanceNo,
+			Generation: generation,
+		},
+		publicIps: &bulkReader[PublicIpInstance]{
+			List: func(ids []string) ([]*PublicIpInstance, error) {
+				if c.SupportVPC {
+					return getVpcPublicIpInstanceList(c, ids)
+				}
+				return getClassicPublicIpInstanceList(c, ids)
+			},
+			Lookup:     c.PublicIpService.Get,
+			ID:         publicIpInstanceNo,
+			Generation: generation,
+		},
+		networkInterfaces: &bulkReader[vserver.NetworkInterface]{
+			List: func(ids []string) ([]*vserver.NetworkInterface, error) {
+				if c.SupportVPC {
+					return getVpcNetworkInterfaceList(c, ids)
+				}
+				return nil, NotSupportClassic("resource `ncloud_network_interface`")
+			},
+			Lookup: func(id string) (*vserver.NetworkInterface, error) {
+				return getNetworkInterface(c, id)
+			},
+			ID:         networkInterfaceNo,
+			Generation: generation,
+		},
+	}
+}
+
+func serverInstanceNo(instance *ServerInstance) string {
+	return ncloud.StringValue(instance.ServerInstanceNo)
+}
+
+func blockStorageInstanceNo(instance *BlockStorage) string {
+	return ncloud.StringValue(instance.BlockStorageInstanceNo)
+}
+
+func publicIpInstanceNo(instance *PublicIpInstance) string {
+	return ncloud.StringValue(instance.PublicIpInstanceNo)
+}
+
+func networkInterfaceNo(instance *vserver.NetworkInterface) string {
+	return ncloud.StringValue(instance.NetworkInterfaceNo)
+}
+
+// readServer returns the server through the read cache, or nil if not found
+func (c *ProviderConfig) readServer(id string) (*ServerInstance, error) {
+	if c.readCache == nil {
+		return c.ServerService.Get(id)
+	}
+	return c.readCache.servers.Get(id)
+}
+
+// readServerList returns the servers of the ids by id through the read cache, mapping the ids not found to nil
+func (c *ProviderConfig) readServerList(ids []string) (map[string]*ServerInstance, error) {
+	if c.readCache == nil {
+		instances := make(map[string]*ServerInstance, len(ids))
+		for _, id := range ids {
+			instance, err := c.ServerService.Get(id)
+			if err != nil {
+				return nil, err
+			}
+			instances[id] = instance
+		}
+		return instances, nil
+	}
+	return c.readCache.servers.GetList(ids)
+}
+
+// readBlockStorage returns the block storage through the read cache, or nil if not found
+func (c *ProviderConfig) readBlockStorage(id string) (*BlockStorage, error) {
+	if c.readCache == nil {
+		return c.BlockStorageService.Get(id)
+	}
+	return c.readCache.blockStorages.Get(id)
+}
+
+// readPublicIp returns the public IP through the read cache, or nil if not found
+func (c *ProviderConfig) readPublicIp(id string) (*PublicIpInstance, error) {
+	if c.readCache == nil {
+		return c.PublicIpService.Get(id)
+	}
+	return c.readCache.publicIps.Get(id)
+}
+
+// readNetworkInterface returns the network interface through the read cache, or nil if not found
+func (c *ProviderConfig) readNetworkInterface(id string) (*vserver.NetworkInterface, error) {
+	if c.readCache == nil {
+		return getNetworkInterface(c, id)
+	}
+	return c.readCache.networkInterfaces.Get(id)
+}
+
+// readNetworkInterfaceList returns the network interfaces of the ids by id through the read cache, mapping the ids not found to nil
+func (c *ProviderConfig) readNetworkInterfaceList(ids []string) (map[string]*vserver.NetworkInterface, error) {
+	if c.readCache == nil {
+		instances := make(map[string]*vserver.NetworkInterface, len(ids))
+		for _, id := range ids {
+			instance, err := getNetworkInterface(c, id)
+			if err != nil {
+				return nil, err
+			}
+			instances[id] = instance
+		}
+		return instances, nil
+	}
+	return c.readCache.networkInterfaces.GetList(ids)
+}
+
+// mutationCounter number of API calls changing instances, shared by the SDK clients of the provider
+type mutationCounter struct {
+	n int64
+}
+
+func (c *mutationCounter) add() {
+	atomic.AddInt64(&c.n, 1)
+}
+
+func (c *mutationCounter) value() int64 {
+	return atomic.LoadInt64(&c.n)
+}
+
+// mutationTransport counts the API calls other than reads once they complete, invalidating the read cache
+type mutationTransport struct {
+	transport http.RoundTripper
+	mutations *mutationCounter
+}
+
+func (t *mutationTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	resp, err := t.transport.RoundTrip(req)
+	if !isReadRequest(req) {
+		t.mutations.add()
+	}
+	return resp, err
+}
@@ -0,0 +1,137 @@
+package ncloud
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"sort"
+	"strings"
+	"sync"
+	"testing"
+)
+
+const testReadCacheServer = `{"serverInstanceNo":"%s","serverName":"server-%s","serverInstanceStatus":{"code":"%s"},"serverInstanceOperation":{"code":"NULL"},"platformType":{"code":"LNX64"},"baseBlockStorageDiskType":{"code":"NET"}}`
+
+// testReadCacheProvider returns the config of a VPC provider with a fake API server of the servers, recording the actions called
+func testReadCacheProvider(t *testing.T, servers ...string) (*ProviderConfig, func() []string) {
+	var mu sync.Mutex
+	var actions []string
+	status := "RUN"
+
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		defer mu.Unlock()
+
+		r.ParseForm()
+		action := r.URL.Path[strings.LastIndex(r.URL.Path, "/")+1:]
+
+		var ids []string
+		for k, v := range r.Form {
+			if k == "serverInstanceNo" || strings.HasPrefix(k, "serverInstanceNoList.") {
+				ids = append(ids, v...)
+			}
+		}
+		sort.Strings(ids)
+		actions = append(actions, fmt.Sprintf("%s(%s)", action, strings.Join(ids, ",")))
+
+		switch action {
+		case "stopServerInstances":
+			status = "NSTOP"
+		case "getServerInstanceList", "getServerInstanceDetail":
+		default:
+			t.Errorf("Unexpected path: %s", r.URL.Path)
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+
+		var list []string
+		for _, id := range ids {
+			for _, server := range servers {
+				if id == server {
+					list = append(list, fmt.Sprintf(testReadCacheServer, id, id, status))
+				}
+			}
+		}
+		fmt.Fprintf(w, `{"%sResponse": {"returnCode":"0","totalRows":%d,"serverInstanceList":[%s]}}`, action, len(list), strings.Join(list, ","))
+	}))
+	t.Cleanup(ts.Close)
+	t.Setenv("NCLOUD_API_GW", ts.URL)
+
+	config := testPlatformProviderConfig(t, map[string]interface{}{"support_vpc": true})
+
+	return config, func() []string {
+		mu.Lock()
+		defer mu.Unlock()
+
+		return append([]string{}, actions...)
+	}
+}
+
+func TestReadCache_servers(t *testing.T) {
+	config, actions := testReadCacheProvider(t, "1", "2", "3")
+
+	var wg sync.WaitGroup
+	for _, id := range []string{"1", "2", "3", "4"} {
+		wg.Add(1)
+		go func(id string) {
+			defer wg.Done()
+
+			instance, err := config.readServer(id)
+			if err != nil {
+				t.Errorf("Unexpected error: %s", err)
+				return
+			}
+
+			if id == "4" {
+				if instance != nil {
+					t.Errorf("Expected not found, Actual: %v", instance)
+				}
+				return
+			}
+
+			if instance == nil || *instance.ServerName != "server-"+id {
+				t.Errorf("Expected: server-%s, Actual: %v", id, instance)
+			}
+		}(id)
+	}
+	wg.Wait()
+
+	// The missing server is confirmed by a lookup of its own
+	expected := "getServerInstanceList(1,2,3,4) getServerInstanceDetail(4)"
+	if actual := strings.Join(actions(), " "); actual != expected {
+		t.Fatalf("Expected: %s, Actual: %s", expected, actual)
+	}
+
+	if _, err := config.readServer("1"); err != nil {
+		t.Fatalf("Unexpected error: %s", err)
+	}
+	if actual := strings.Join(actions(), " "); actual != expected {
+		t.Fatalf("Expected cached, Actual: %s", actual)
+	}
+}
+
+func TestReadCache_mutation(t *testing.T) {
+	config, actions := testReadCacheProvider(t, "1")
+
+	if instance, err := config.readServer("1"); err != nil || *instance.ServerInstanceStatus != "RUN" {
+		t.Fatalf("Expected: RUN, Actual: %v, %v", instance, err)
+	}
+
+	if err := config.ServerService.Stop("1"); err != nil {
+		t.Fatalf("Unexpected error: %s", err)
+	}
+
+	instance, err := config.readServer("1")
+	if err != nil {
+		t.Fatalf("Unexpected error: %s", err)
+	}
+
+	if *instance.ServerInstanceStatus != "NSTOP" {
+		t.Fatalf("Expected: NSTOP, Actual: %s", *instance.ServerInstanceStatus)
+	}
+
+	expected := "getServerInstanceList(1) stopServerInstances(1) getServerInstanceList(1)"
+	if actual := strings.Join(actions(), " "); actual != expected {
+		t.Fatalf("Expected: %s, Actual: %s", expected, actual)
+	}
+}
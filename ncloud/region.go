@@ -3,8 +3,8 @@ package ncloud
 import (
 	"fmt"
 	"github.com/NaverCloudPlatform/ncloud-sdk-go-v2/services/vserver"
-	"os"
 
+	"github.com/NaverCloudPlatform/ncloud-sdk-go-v2/ncloud"
 	"github.com/NaverCloudPlatform/ncloud-sdk-go-v2/services/server"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
@@ -15,103 +15,46 @@ type Region struct {
 	RegionName *string `json:"regionName,omitempty"`
 }
 
-var regionCacheByCode = make(map[string]Region)
-
-func parseRegionNoParameter(d *schema.ResourceData) (*string, error) {
+func parseRegionNoParameter(config *ProviderConfig, d *schema.ResourceData) (*string, error) {
 	if regionCode, regionCodeOk := d.GetOk("region"); regionCodeOk {
-		regionNo := getRegionNoByCode(regionCode.(string))
-		if regionNo == nil {
-			return nil, fmt.Errorf("no region data for region_code `%s`. please change region_code and try again", regionCode.(string))
+		region, err := config.regionCache.getRegion(regionCode.(string))
+		if err != nil {
+			return nil, err
+		}
+		if region == nil || region.RegionNo == nil {
+			return nil, NewArgumentError("region", fmt.Errorf("no region data for region_code `%s`. please change region_code and try again", regionCode.(string)))
 		}
-		return regionNo, nil
+		return region.RegionNo, nil
 	}
 
 	// provider region
-	if regionCode := os.Getenv("NCLOUD_REGION"); regionCode != "" {
-		regionNo := getRegionNoByCode(regionCode)
-		if regionNo == nil {
-			return nil, fmt.Errorf("no region data for region_code `%s`. please change region_code and try again", regionCode)
-		}
-		return regionNo, nil
+	if !config.SupportVPC {
+		return config.regionNo()
 	}
 
 	return nil, nil
 }
 
-func parseRegionCodeParameter(client *NcloudAPIClient, d *schema.ResourceData) (*string, error) {
+func parseRegionCodeParameter(config *ProviderConfig, d *schema.ResourceData) (*string, error) {
 	if regionCode, regionCodeOk := d.GetOk("region"); regionCodeOk {
-		region, err := getRegionByCode(client, regionCode.(string))
-		if region == nil || err != nil {
-			return nil, fmt.Errorf("no region data for region_code `%s`. please change region_code and try again", regionCode.(string))
+		region, err := config.regionCache.getRegion(regionCode.(string))
+		if err != nil {
+			return nil, err
+		}
+		if region == nil {
+			return nil, NewArgumentError("region", fmt.Errorf("no region data for region_code `%s`. please change region_code and try again", regionCode.(string)))
 		}
 		return region.RegionCode, nil
 	}
 
 	// provider region
-	if regionCode := os.Getenv("NCLOUD_REGION"); regionCode != "" {
-		region, err := getRegionByCode(client, regionCode)
-		if region == nil || err != nil {
-			return nil, fmt.Errorf("no region data for region_code `%s`. please change region_code and try again", regionCode)
-		}
-		return region.RegionCode, nil
+	if config.RegionCode != "" {
+		return ncloud.String(config.RegionCode), nil
 	}
 
 	return nil, nil
 }
 
-func getRegionNoByCode(code string) *string {
-	if region, ok := regionCacheByCode[code]; ok {
-		return region.RegionNo
-	}
-	return nil
-}
-
-func getRegionByCode(client *NcloudAPIClient, code string) (*server.Region, error) {
-	resp, err := client.server.V2Api.GetRegionList(&server.GetRegionListRequest{})
-	if err != nil {
-		return nil, err
-	}
-	regionList := resp.RegionList
-
-	var filteredRegion *server.Region
-	for _, region := range regionList {
-		if code == *region.RegionCode {
-			filteredRegion = region
-			break
-		}
-	}
-
-	return filteredRegion, nil
-}
-
-func setRegionCache(client *NcloudAPIClient, supportVPC bool) error {
-	var regionList []*Region
-	var err error
-	if supportVPC {
-		regionList, err = getVpcRegionList(client)
-	} else {
-		regionList, err = getClassicRegionList(client)
-	}
-
-	if err != nil {
-		return err
-	}
-
-	for _, r := range regionList {
-		region := Region{
-			RegionCode: r.RegionCode,
-			RegionName: r.RegionName,
-		}
-		if !supportVPC {
-			region.RegionNo = r.RegionNo
-		}
-
-		regionCacheByCode[*region.RegionCode] = region
-	}
-
-	return nil
-}
-
 func getClassicRegionList(client *NcloudAPIClient) ([]*Region, error) {
 	resp, err := client.server.V2Api.GetRegionList(&server.GetRegionListRequest{})
 	if err != nil {
@@ -147,8 +90,3 @@ func getVpcRegionList(client *NcloudAPIClient) ([]*Region, error) {
 
 	return regionList, nil
 }
-
-func isValidRegionCode(code string) bool {
-	_, ok := regionCacheByCode[code]
-	return ok
-}
@@ -0,0 +1,211 @@
+package ncloud
+
+import (
+	"crypto/sha256"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"log"
+	"os"
+	"path/filepath"
+	"sync"
+	"time"
+)
+
+// regionCache region and zone metadata of a provider, loaded on first use and optionally persisted to a file with a TTL
+type regionCache struct {
+	mu         sync.Mutex
+	client     *NcloudAPIClient
+	supportVPC bool
+	regionCode string
+
+	// file is empty if the disk cache is disabled
+	file string
+	ttl  time.Duration
+
+	regions []*Region
+	zones   []*Zone
+}
+
+type regionCacheFile struct {
+	CreatedAt time.Time `json:"created_at"`
+	Regions   []*Region `json:"regions,omitempty"`
+	Zones     []*Zone   `json:"zones,omitempty"`
+}
+
+// newRegionCache returns a cache persisted under dir for ttl. Caches of different platforms, regions or endpoints are kept in different files.
+func newRegionCache(client *NcloudAPIClient, supportVPC bool, regionCode string, dir string, ttl time.Duration, endpoint string) *regionCache {
+	c := &regionCache{
+		client:     client,
+		supportVPC: supportVPC,
+		regionCode: regionCode,
+		ttl:        ttl,
+	}
+
+	if dir != "" && ttl > 0 {
+		platform := "classic"
+		if supportVPC {
+			platform = "vpc"
+		}
+		hash := sha256.Sum256([]byte(endpoint))
+		c.file = filepath.Join(dir, fmt.Sprintf("%s-%s-%x.json", platform, regionCode, hash[:4]))
+	}
+
+	return c
+}
+
+func (c *regionCache) getRegions() ([]*Region, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	return c.loadRegions()
+}
+
+// getRegion returns the region of the code, or nil if not found
+func (c *regionCache) getRegion(code string) (*Region, error) {
+	regions, err := c.getRegions()
+	if err != nil {
+		return nil, err
+	}
+
+	for _, r := range regions {
+		if r.RegionCode != nil && *r.RegionCode == code {
+			return r, nil
+		}
+	}
+	return nil, nil
+}
+
+// getZones returns zones of the provider region
+func (c *regionCache) getZones() ([]*Zone, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if c.zones != nil {
+		return c.zones, nil
+	}
+
+	if cached := c.readFile(); cached != nil && cached.Zones != nil {
+		c.zones = cached.Zones
+		return c.zones, nil
+	}
+
+	var zones []*Zone
+	var err error
+	if c.supportVPC {
+		zones, err = getVpcZoneList(c.client, c.regionCode)
+	} else {
+		var regionNo *string
+		if regionNo, err = c.regionNo(); err == nil {
+			zones, err = getClassicZoneList(c.client, *regionNo)
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	c.zones = zones
+	c.writeFile()
+
+	return c.zones, nil
+}
+
+// regionNo returns region number of the provider region on Classic. c.mu must be held.
+func (c *regionCache) regionNo() (*string, error) {
+	regions, err := c.loadRegions()
+	if err != nil {
+		return nil, err
+	}
+
+	for _, r := range regions {
+		if r.RegionCode != nil && *r.RegionCode == c.regionCode && r.RegionNo != nil {
+			return r.RegionNo, nil
+		}
+	}
+	return nil, fmt.Errorf("no region data for region_code `%s`. please change region_code and try again", c.regionCode)
+}
+
+// loadRegions loads regions from memory, the disk cache or the API. c.mu must be held.
+func (c *regionCache) loadRegions() ([]*Region, error) {
+	if c.regions != nil {
+		return c.regions, nil
+	}
+
+	if cached := c.readFile(); cached != nil && cached.Regions != nil {
+		c.regions = cached.Regions
+		return c.regions, nil
+	}
+
+	var regions []*Region
+	var err error
+	if c.supportVPC {
+		regions, err = getVpcRegionList(c.client)
+	} else {
+		regions, err = getClassicRegionList(c.client)
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	c.regions = regions
+	c.writeFile()
+
+	return c.regions, nil
+}
+
+// readFile returns the disk cache, or nil if it is disabled, missing or expired
+func (c *regionCache) readFile() *regionCacheFile {
+	if c.file == "" {
+		return nil
+	}
+
+	b, err := ioutil.ReadFile(c.file)
+	if err != nil {
+		return nil
+	}
+
+	cached := &regionCacheFile{}
+	if err := json.Unmarshal(b, cached); err != nil {
+		log.Printf("[WARN] ignoring invalid region cache (%s): %s", c.file, err)
+		return nil
+	}
+
+	if time.Since(cached.CreatedAt) > c.ttl {
+		return nil
+	}
+
+	return cached
+}
+
+// writeFile persists loaded regions and zones. Errors are logged only, as the cache is optional.
+func (c *regionCache) writeFile() {
+	if c.file == "" {
+		return
+	}
+
+	cached := c.readFile()
+	if cached == nil {
+		cached = &regionCacheFile{CreatedAt: time.Now()}
+	}
+
+	if c.regions != nil {
+		cached.Regions = c.regions
+	}
+	if c.zones != nil {
+		cached.Zones = c.zones
+	}
+
+	b, err := json.Marshal(cached)
+	if err == nil {
+		err = os.MkdirAll(filepath.Dir(c.file), 0700)
+	}
+	if err == nil {
+		err = ioutil.WriteFile(c.file, b, 0600)
+	}
+
+	if err != nil {
+		log.Printf("[WARN] error writing region cache (%s): %s", c.file, err)
+	}
+}
@@ -0,0 +1,134 @@
+package ncloud
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func testRegionCacheServer(t *testing.T, calls *int32) *NcloudAPIClient {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(calls, 1)
+		switch r.URL.Path {
+		case "/server/v2/getRegionList":
+			w.Write([]byte(`{"getRegionListResponse": {"returnCode":"0","regionList":[{"regionNo":"1","regionCode":"KR"},{"regionNo":"5","regionCode":"JPN"}]}}`))
+		case "/server/v2/getZoneList":
+			if regionNo := r.FormValue("regionNo"); regionNo != "1" {
+				t.Errorf("Expected regionNo: 1, Actual: %s", regionNo)
+			}
+			w.Write([]byte(`{"getZoneListResponse": {"returnCode":"0","zoneList":[{"zoneNo":"2","zoneCode":"KR-1"},{"zoneNo":"3","zoneCode":"KR-2"}]}}`))
+		default:
+			t.Errorf("Unexpected path: %s", r.URL.Path)
+		}
+	}))
+	t.Cleanup(ts.Close)
+
+	client, err := (&Config{
+		AccessKey: "access",
+		SecretKey: "secret",
+		Region:    "KR",
+		Endpoints: map[string]string{"server": ts.URL + "/server/v2"},
+	}).Client()
+	if err != nil {
+		t.Fatal(err)
+	}
+	return client
+}
+
+func TestRegionCache_lazy(t *testing.T) {
+	var calls int32
+	client := testRegionCacheServer(t, &calls)
+
+	config := &ProviderConfig{
+		RegionCode:  "KR",
+		Client:      client,
+		regionCache: newRegionCache(client, false, "KR", "", 0, ""),
+	}
+
+	if calls != 0 {
+		t.Fatalf("Expected no API call before first use, Actual: %d", calls)
+	}
+
+	var wg sync.WaitGroup
+	for i := 0; i < 10; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			if regionNo, err := config.regionNo(); err != nil || *regionNo != "1" {
+				t.Errorf("Expected region no: 1, Actual: %v, %v", regionNo, err)
+			}
+			if zoneNo := getZoneNoByCode(config, "KR-2"); zoneNo != "3" {
+				t.Errorf("Expected zone no: 3, Actual: %s", zoneNo)
+			}
+		}()
+	}
+	wg.Wait()
+
+	if zoneCode := getZoneCodeByNo(config, "2"); zoneCode != "KR-1" {
+		t.Fatalf("Expected zone code: KR-1, Actual: %s", zoneCode)
+	}
+
+	if region, err := config.regionCache.getRegion("JPN"); err != nil || region == nil || *region.RegionNo != "5" {
+		t.Fatalf("Expected region JPN, Actual: %v, %v", region, err)
+	}
+
+	if region, _ := config.regionCache.getRegion("US"); region != nil {
+		t.Fatalf("Expected no region for US, Actual: %v", region)
+	}
+
+	if calls != 2 {
+		t.Fatalf("Expected 1 call of region list and 1 call of zone list, Actual: %d calls", calls)
+	}
+}
+
+func TestRegionCache_unknownRegion(t *testing.T) {
+	var calls int32
+	client := testRegionCacheServer(t, &calls)
+
+	config := &ProviderConfig{Client: client, regionCache: newRegionCache(client, false, "US", "", 0, "")}
+	if _, err := config.regionNo(); err == nil {
+		t.Fatal("Expected error for unknown region")
+	}
+}
+
+func TestRegionCache_disk(t *testing.T) {
+	var calls int32
+	client := testRegionCacheServer(t, &calls)
+	dir := t.TempDir()
+
+	cache := newRegionCache(client, false, "KR", dir, time.Hour, "https://ncloud.apigw.ntruss.com")
+	if _, err := cache.getZones(); err != nil {
+		t.Fatalf("Unexpected error: %s", err)
+	}
+
+	// another provider instance reads the disk cache
+	cache = newRegionCache(client, false, "KR", dir, time.Hour, "https://ncloud.apigw.ntruss.com")
+	if zones, err := cache.getZones(); err != nil || len(zones) != 2 {
+		t.Fatalf("Expected 2 zones, Actual: %v, %v", zones, err)
+	}
+	if regions, err := cache.getRegions(); err != nil || len(regions) != 2 {
+		t.Fatalf("Expected 2 regions, Actual: %v, %v", regions, err)
+	}
+
+	if calls != 2 {
+		t.Fatalf("Expected disk cache to be used, Actual: %d calls", calls)
+	}
+
+	// different endpoint
+	cache = newRegionCache(client, false, "KR", dir, time.Hour, "https://ncloud.apigw.fin-ntruss.com")
+	cache.getRegions()
+	if calls != 3 {
+		t.Fatalf("Expected disk cache not to be shared across endpoints, Actual: %d calls", calls)
+	}
+
+	// expired
+	cache = newRegionCache(client, false, "KR", dir, time.Nanosecond, "https://ncloud.apigw.ntruss.com")
+	time.Sleep(time.Millisecond)
+	cache.getRegions()
+	if calls != 4 {
+		t.Fatalf("Expected expired disk cache to be ignored, Actual: %d calls", calls)
+	}
+}
@@ -1,8 +1,9 @@
 package ncloud
 
 import (
+	"context"
 	"fmt"
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"log"
 	"time"
 
@@ -18,11 +19,11 @@ func init() {
 
 func resourceNcloudAccessControlGroup() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceNcloudAccessControlGroupCreate,
-		Read:   resourceNcloudAccessControlGroupRead,
-		Delete: resourceNcloudAccessControlGroupDelete,
+		CreateContext: resourceNcloudAccessControlGroupCreate,
+		ReadContext:   resourceNcloudAccessControlGroupRead,
+		DeleteContext: resourceNcloudAccessControlGroupDelete,
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
 		},
 		Schema: map[string]*schema.Schema{
 			"vpc_no": {
@@ -56,27 +57,27 @@ func resourceNcloudAccessControlGroup() *schema.Resource {
 	}
 }
 
-func resourceNcloudAccessControlGroupCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceNcloudAccessControlGroupCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	config := meta.(*ProviderConfig)
 
 	instance, err := createAccessControlGroup(d, config)
 
 	if err != nil {
-		return err
+		return ToDiagnostics(err)
 	}
 
 	d.SetId(*instance.AccessControlGroupNo)
 	log.Printf("[INFO] ACG ID: %s", d.Id())
 
-	return resourceNcloudAccessControlGroupRead(d, meta)
+	return resourceNcloudAccessControlGroupRead(ctx, d, meta)
 }
 
-func resourceNcloudAccessControlGroupRead(d *schema.ResourceData, meta interface{}) error {
+func resourceNcloudAccessControlGroupRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	config := meta.(*ProviderConfig)
 
 	instance, err := getAccessControlGroup(config, d.Id())
 	if err != nil {
-		return err
+		return ToDiagnostics(err)
 	}
 
 	if instance == nil {
@@ -94,11 +95,11 @@ func resourceNcloudAccessControlGroupRead(d *schema.ResourceData, meta interface
 	return nil
 }
 
-func resourceNcloudAccessControlGroupDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceNcloudAccessControlGroupDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	config := meta.(*ProviderConfig)
 
-	if err := deleteAccessControlGroup(config, d.Id()); err != nil {
-		return err
+	if err := deleteAccessControlGroup(ctx, config, d.Id()); err != nil {
+		return ToDiagnostics(err)
 	}
 
 	return nil
@@ -160,15 +161,15 @@ func createVpcAccessControlGroup(d *schema.ResourceData, config *ProviderConfig)
 	return resp.AccessControlGroupList[0], nil
 }
 
-func deleteAccessControlGroup(config *ProviderConfig, id string) error {
+func deleteAccessControlGroup(ctx context.Context, config *ProviderConfig, id string) error {
 	if config.SupportVPC {
-		return deleteVpcAccessControlGroup(config, id)
+		return deleteVpcAccessControlGroup(ctx, config, id)
 	}
 
 	return NotSupportClassic("resource `ncloud_access_control_group`")
 }
 
-func deleteVpcAccessControlGroup(config *ProviderConfig, id string) error {
+func deleteVpcAccessControlGroup(ctx context.Context, config *ProviderConfig, id string) error {
 	accessControlGroup, err := getAccessControlGroup(config, id)
 	if err != nil {
 		return err
@@ -192,27 +193,28 @@ func deleteVpcAccessControlGroup(config *ProviderConfig, id string) error {
 	}
 	logResponse("deleteVpcAccessControlGroup", resp)
 
-	if err := waitForVpcAccessControlGroupDeletion(config, id); err != nil {
+	if err := waitForVpcAccessControlGroupDeletion(ctx, config, id); err != nil {
 		return err
 	}
 
 	return nil
 }
 
-func waitForVpcAccessControlGroupDeletion(config *ProviderConfig, id string) error {
-	stateConf := &resource.StateChangeConf{
+func waitForVpcAccessControlGroupDeletion(ctx context.Context, config *ProviderConfig, id string) error {
+	waiter := &Waiter[vserver.AccessControlGroup]{
 		Pending: []string{"RUN"},
 		Target:  []string{"TERMINATED"},
-		Refresh: func() (interface{}, string, error) {
-			instance, err := getAccessControlGroup(config, id)
-			return VpcCommonStateRefreshFunc(instance, err, "AccessControlGroupStatus")
+		Refresh: func() (*vserver.AccessControlGroup, error) {
+			return getAccessControlGroup(config, id)
 		},
-		Timeout:    DefaultTimeout,
-		Delay:      2 * time.Second,
-		MinTimeout: 3 * time.Second,
+		Status: func(instance *vserver.AccessControlGroup) string {
+			return commonCodeValue(instance.AccessControlGroupStatus)
+		},
+		Timeout: DefaultTimeout,
+		Delay:   2 * time.Second,
 	}
 
-	_, err := stateConf.WaitForState()
+	_, err := waiter.Wait(ctx)
 	if err != nil {
 		return fmt.Errorf("error waiting for Access Control Group (%s) to become terminated: %s", id, err)
 	}
@@ -220,20 +222,21 @@ func waitForVpcAccessControlGroupDeletion(config *ProviderConfig, id string) err
 	return nil
 }
 
-func waitForVpcAccessControlGroupRunning(config *ProviderConfig, id string) error {
-	stateConf := &resource.StateChangeConf{
+func waitForVpcAccessControlGroupRunning(ctx context.Context, config *ProviderConfig, id string) error {
+	waiter := &Waiter[vserver.AccessControlGroup]{
 		Pending: []string{"SET"},
 		Target:  []string{"RUN"},
-		Refresh: func() (interface{}, string, error) {
-			instance, err := getAccessControlGroup(config, id)
-			return VpcCommonStateRefreshFunc(instance, err, "AccessControlGroupStatus")
+		Refresh: func() (*vserver.AccessControlGroup, error) {
+			return getAccessControlGroup(config, id)
+		},
+		Status: func(instance *vserver.AccessControlGroup) string {
+			return commonCodeValue(instance.AccessControlGroupStatus)
 		},
-		Timeout:    DefaultTimeout,
-		Delay:      2 * time.Second,
-		MinTimeout: 3 * time.Second,
+		Timeout: DefaultTimeout,
+		Delay:   2 * time.Second,
 	}
 
-	_, err := stateConf.WaitForState()
+	_, err := waiter.Wait(ctx)
 	if err != nil {
 		return fmt.Errorf("error waiting for Access Control Group (%s) to become running: %s", id, err)
 	}
@@ -31,6 +31,11 @@ func TestAccResourceNcloudAccessControlGroupRule_basic(t *testing.T) {
 					resource.TestCheckResourceAttr(resourceName, "outbound.#", "1"),
 				),
 			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
 		},
 	})
 }
@@ -1,6 +1,7 @@
 package ncloud
 
 import (
+	"context"
 	"fmt"
 	"strings"
 	"time"
@@ -8,6 +9,7 @@ import (
 	"github.com/NaverCloudPlatform/ncloud-sdk-go-v2/ncloud"
 	"github.com/NaverCloudPlatform/ncloud-sdk-go-v2/services/autoscaling"
 	"github.com/NaverCloudPlatform/ncloud-sdk-go-v2/services/vautoscaling"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
@@ -19,14 +21,15 @@ func init() {
 
 func resourceNcloudAutoScalingGroup() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceNcloudAutoScalingGroupCreate,
-		Read:   resourceNcloudAutoScalingGroupRead,
-		Update: resourceNcloudAutoScalingGroupUpdate,
-		Delete: resourceNcloudAutoScalingGroupDelete,
+		CreateContext: resourceNcloudAutoScalingGroupCreate,
+		ReadContext:   resourceNcloudAutoScalingGroupRead,
+		UpdateContext: resourceNcloudAutoScalingGroupUpdate,
+		DeleteContext: resourceNcloudAutoScalingGroupDelete,
 		Importer: &schema.ResourceImporter{
 			StateContext: schema.ImportStatePassthroughContext,
 		},
 		Schema: map[string]*schema.Schema{
+			"platform": platformSchema(),
 			"auto_scaling_group_no": {
 				Type:     schema.TypeString,
 				Computed: true,
@@ -122,20 +125,23 @@ func resourceNcloudAutoScalingGroup() *schema.Resource {
 	}
 }
 
-func resourceNcloudAutoScalingGroupCreate(d *schema.ResourceData, meta interface{}) error {
-	config := meta.(*ProviderConfig)
+func resourceNcloudAutoScalingGroupCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config, err := resourcePlatformConfig(d, meta)
+	if err != nil {
+		return ToDiagnostics(err)
+	}
 
 	id, err := createAutoScalingGroup(d, config)
 	if err != nil {
-		return err
+		return ToDiagnostics(err)
 	}
 
 	d.SetId(ncloud.StringValue(id))
-	if err := waitForAutoScalingGroupCapacity(d, config); err != nil {
-		return err
+	if err := waitForAutoScalingGroupCapacity(ctx, d, config); err != nil {
+		return ToDiagnostics(err)
 	}
 
-	return resourceNcloudAutoScalingGroupRead(d, meta)
+	return resourceNcloudAutoScalingGroupRead(ctx, d, meta)
 }
 
 func createAutoScalingGroup(d *schema.ResourceData, config *ProviderConfig) (*string, error) {
@@ -222,12 +228,15 @@ func createClassicAutoScalingGroup(d *schema.ResourceData, config *ProviderConfi
 	return resp.AutoScalingGroupList[0].AutoScalingGroupNo, nil
 }
 
-func resourceNcloudAutoScalingGroupRead(d *schema.ResourceData, meta interface{}) error {
-	config := meta.(*ProviderConfig)
+func resourceNcloudAutoScalingGroupRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config, err := resourcePlatformConfig(d, meta)
+	if err != nil {
+		return ToDiagnostics(err)
+	}
 
 	autoScalingGroup, err := getAutoScalingGroup(config, d.Id())
 	if err != nil {
-		return err
+		return ToDiagnostics(err)
 	}
 
 	if autoScalingGroup == nil {
@@ -290,8 +299,13 @@ func getVpcAutoScalingGroup(config *ProviderConfig, id string) (*AutoScalingGrou
 
 func getClassicAutoScalingGroup(config *ProviderConfig, id string) (*AutoScalingGroup, error) {
 	no := ncloud.String(id)
+	regionNo, err := config.regionNo()
+	if err != nil {
+		return nil, err
+	}
+
 	reqParams := &autoscaling.GetAutoScalingGroupListRequest{
-		RegionNo: &config.RegionNo,
+		RegionNo: regionNo,
 	}
 
 	logCommonRequest("getClassicAutoScalingGroup", reqParams)
@@ -325,13 +339,16 @@ func getClassicAutoScalingGroup(config *ProviderConfig, id string) (*AutoScaling
 	return nil, nil
 }
 
-func resourceNcloudAutoScalingGroupUpdate(d *schema.ResourceData, meta interface{}) error {
-	config := meta.(*ProviderConfig)
+func resourceNcloudAutoScalingGroupUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config, err := resourcePlatformConfig(d, meta)
+	if err != nil {
+		return ToDiagnostics(err)
+	}
 	if err := updateAutoScalingGroup(d, config); err != nil {
-		return err
+		return ToDiagnostics(err)
 	}
 
-	return resourceNcloudAutoScalingGroupRead(d, config)
+	return resourceNcloudAutoScalingGroupRead(ctx, d, config)
 }
 
 func updateAutoScalingGroup(d *schema.ResourceData, config *ProviderConfig) error {
@@ -452,24 +469,27 @@ func changeClassicAutoScalingGroup(d *schema.ResourceData, config *ProviderConfi
 	return nil
 }
 
-func resourceNcloudAutoScalingGroupDelete(d *schema.ResourceData, meta interface{}) error {
-	config := meta.(*ProviderConfig)
-	if err := deleteAutoScalingGroup(d, config); err != nil {
-		return err
+func resourceNcloudAutoScalingGroupDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config, err := resourcePlatformConfig(d, meta)
+	if err != nil {
+		return ToDiagnostics(err)
+	}
+	if err := deleteAutoScalingGroup(ctx, d, config); err != nil {
+		return ToDiagnostics(err)
 	}
 	return nil
 }
 
-func deleteAutoScalingGroup(d *schema.ResourceData, config *ProviderConfig) error {
+func deleteAutoScalingGroup(ctx context.Context, d *schema.ResourceData, config *ProviderConfig) error {
 	d.Timeout(schema.TimeoutDelete)
 	if config.SupportVPC {
-		return deleteVpcAutoScalingGroup(config, d.Id())
+		return deleteVpcAutoScalingGroup(ctx, config, d.Id())
 	} else {
-		return deleteClassicAutoScalingGroup(config, d.Id())
+		return deleteClassicAutoScalingGroup(ctx, config, d.Id())
 	}
 }
 
-func deleteVpcAutoScalingGroup(config *ProviderConfig, id string) error {
+func deleteVpcAutoScalingGroup(ctx context.Context, config *ProviderConfig, id string) error {
 	asg, err := getAutoScalingGroup(config, id)
 	if err != nil {
 		return err
@@ -487,18 +507,18 @@ func deleteVpcAutoScalingGroup(config *ProviderConfig, id string) error {
 		return err
 	}
 
-	if err := waitForVpcInAutoScalingGroupServerInstanceListDeletion(config, id); err != nil {
+	if err := waitForVpcInAutoScalingGroupServerInstanceListDeletion(ctx, config, id); err != nil {
 		return err
 	}
 
-	if err := waitForVpcAutoScalingGroupDeletion(config, id); err != nil {
+	if err := waitForVpcAutoScalingGroupDeletion(ctx, config, id); err != nil {
 		return err
 	}
 
 	return nil
 }
 
-func deleteClassicAutoScalingGroup(config *ProviderConfig, id string) error {
+func deleteClassicAutoScalingGroup(ctx context.Context, config *ProviderConfig, id string) error {
 	asg, err := getAutoScalingGroup(config, id)
 	if err != nil {
 		return err
@@ -517,12 +537,12 @@ func deleteClassicAutoScalingGroup(config *ProviderConfig, id string) error {
 	}
 
 	// 2. Delete Server Instance List in AutoScalingGroup
-	if err := waitForClassicInAutoScalingGroupServerInstanceListDeletion(config, id); err != nil {
+	if err := waitForClassicInAutoScalingGroupServerInstanceListDeletion(ctx, config, id); err != nil {
 		return err
 	}
 
 	// 3. Delete Auto Scaling Group
-	if err := waitForClassicAutoScalingGroupDeletion(config, ncloud.StringValue(asg.AutoScalingGroupName)); err != nil {
+	if err := waitForClassicAutoScalingGroupDeletion(ctx, config, ncloud.StringValue(asg.AutoScalingGroupName)); err != nil {
 		return err
 	}
 
@@ -558,9 +578,14 @@ func getClassicInAutoScalingGroupServerInstanceList(config *ProviderConfig, id s
 		return nil, err
 	}
 
+	regionNo, err := config.regionNo()
+	if err != nil {
+		return nil, err
+	}
+
 	reqParams := &autoscaling.GetAutoScalingGroupListRequest{
 		AutoScalingGroupNameList: []*string{tmpAsg.AutoScalingGroupName},
-		RegionNo:                 &config.RegionNo,
+		RegionNo:                 regionNo,
 	}
 
 	resp, err := config.Client.autoscaling.V2Api.GetAutoScalingGroupList(reqParams)
@@ -580,7 +605,7 @@ func getClassicInAutoScalingGroupServerInstanceList(config *ProviderConfig, id s
 	return list, nil
 }
 
-func waitForClassicInAutoScalingGroupServerInstanceListDeletion(config *ProviderConfig, id string) error {
+func waitForClassicInAutoScalingGroupServerInstanceListDeletion(ctx context.Context, config *ProviderConfig, id string) error {
 	stateConf := &resource.StateChangeConf{
 		Pending: []string{"INSVC"},
 		Target:  []string{"TERMT"},
@@ -600,13 +625,13 @@ func waitForClassicInAutoScalingGroupServerInstanceListDeletion(config *Provider
 		Timeout:    DefaultStopTimeout * 3,
 	}
 
-	if _, err := stateConf.WaitForState(); err != nil {
+	if _, err := stateConf.WaitForStateContext(ctx); err != nil {
 		return fmt.Errorf("Error waiting for InAutoScalingGroupServerInstanceList (%s) to become deleting: %s", id, err)
 	}
 	return nil
 }
 
-func waitForVpcInAutoScalingGroupServerInstanceListDeletion(config *ProviderConfig, id string) error {
+func waitForVpcInAutoScalingGroupServerInstanceListDeletion(ctx context.Context, config *ProviderConfig, id string) error {
 	stateConf := &resource.StateChangeConf{
 		Pending: []string{"INSVC"},
 		Target:  []string{"TERMT"},
@@ -626,13 +651,13 @@ func waitForVpcInAutoScalingGroupServerInstanceListDeletion(config *ProviderConf
 		Timeout:    DefaultStopTimeout * 3,
 	}
 
-	if _, err := stateConf.WaitForState(); err != nil {
+	if _, err := stateConf.WaitForStateContext(ctx); err != nil {
 		return fmt.Errorf("Error waiting for InAutoScalingGroupServerInstanceList (%s) to become deleting: %s", id, err)
 	}
 	return nil
 }
 
-func waitForClassicAutoScalingGroupDeletion(config *ProviderConfig, name string) error {
+func waitForClassicAutoScalingGroupDeletion(ctx context.Context, config *ProviderConfig, name string) error {
 	stateConf := &resource.StateChangeConf{
 		Pending: []string{"RUN"},
 		Target:  []string{"DELETE"},
@@ -643,8 +668,7 @@ func waitForClassicAutoScalingGroupDeletion(config *ProviderConfig, name string)
 			}
 			resp, err := client.autoscaling.V2Api.DeleteAutoScalingGroup(reqParams)
 			if err != nil {
-				errBody, _ := GetCommonErrorBody(err)
-				if errBody.ReturnCode == ApiErrorASGScalingIsActive || errBody.ReturnCode == ApiErrorASGIsUsingPolicyOrLaunchConfiguration {
+				if apiErrorCode(err) == ApiErrorASGScalingIsActive || apiErrorCode(err) == ApiErrorASGIsUsingPolicyOrLaunchConfiguration {
 					return resp, "RUN", nil
 				} else {
 					return 0, "", err
@@ -658,13 +682,13 @@ func waitForClassicAutoScalingGroupDeletion(config *ProviderConfig, name string)
 		Timeout:    DefaultTimeout,
 	}
 
-	if _, err := stateConf.WaitForState(); err != nil {
+	if _, err := stateConf.WaitForStateContext(ctx); err != nil {
 		return fmt.Errorf("Error waiting for AutoScalingGroup (%s) to become deleting: %s", name, err)
 	}
 	return nil
 }
 
-func waitForVpcAutoScalingGroupDeletion(config *ProviderConfig, id string) error {
+func waitForVpcAutoScalingGroupDeletion(ctx context.Context, config *ProviderConfig, id string) error {
 	stateConf := &resource.StateChangeConf{
 		Pending: []string{"RUN"},
 		Target:  []string{"DELETE"},
@@ -675,8 +699,7 @@ func waitForVpcAutoScalingGroupDeletion(config *ProviderConfig, id string) error
 			}
 			resp, err := client.vautoscaling.V2Api.DeleteAutoScalingGroup(reqParams)
 			if err != nil {
-				errBody, _ := GetCommonErrorBody(err)
-				if errBody.ReturnCode == ApiErrorASGIsUsingPolicyOrLaunchConfigurationOnVpc {
+				if apiErrorCode(err) == ApiErrorASGIsUsingPolicyOrLaunchConfigurationOnVpc {
 					return resp, "RUN", nil
 				} else {
 					return 0, "", err
@@ -690,13 +713,13 @@ func waitForVpcAutoScalingGroupDeletion(config *ProviderConfig, id string) error
 		Timeout:    DefaultTimeout,
 	}
 
-	if _, err := stateConf.WaitForState(); err != nil {
+	if _, err := stateConf.WaitForStateContext(ctx); err != nil {
 		return fmt.Errorf("Error waiting for AutoScalingGroup (%s) to become deleting: %s", id, err)
 	}
 	return nil
 }
 
-func waitForAutoScalingGroupCapacity(d *schema.ResourceData, config *ProviderConfig) error {
+func waitForAutoScalingGroupCapacity(ctx context.Context, d *schema.ResourceData, config *ProviderConfig) error {
 	wait, err := time.ParseDuration(d.Get("wait_for_capacity_timeout").(string))
 	if err != nil {
 		return err
@@ -707,14 +730,14 @@ func waitForAutoScalingGroupCapacity(d *schema.ResourceData, config *ProviderCon
 	}
 
 	if config.SupportVPC {
-		return waitForVpcAutoScalingGroupCapacity(d, config, wait)
+		return waitForVpcAutoScalingGroupCapacity(ctx, d, config, wait)
 	} else {
-		return waitForClassicAutoScalingGroupCapacity(d, config, wait)
+		return waitForClassicAutoScalingGroupCapacity(ctx, d, config, wait)
 	}
 }
 
-func waitForVpcAutoScalingGroupCapacity(d *schema.ResourceData, config *ProviderConfig, wait time.Duration) error {
-	return resource.Retry(wait, func() *resource.RetryError {
+func waitForVpcAutoScalingGroupCapacity(ctx context.Context, d *schema.ResourceData, config *ProviderConfig, wait time.Duration) error {
+	return resource.RetryContext(ctx, wait, func() *resource.RetryError {
 		asg, err := getVpcAutoScalingGroup(config, d.Id())
 		asgServerInstanceList, err := getVpcInAutoScalingGroupServerInstanceList(config, d.Id())
 		if err != nil {
@@ -746,8 +769,8 @@ func waitForVpcAutoScalingGroupCapacity(d *schema.ResourceData, config *Provider
 	})
 }
 
-func waitForClassicAutoScalingGroupCapacity(d *schema.ResourceData, config *ProviderConfig, wait time.Duration) error {
-	return resource.Retry(wait, func() *resource.RetryError {
+func waitForClassicAutoScalingGroupCapacity(ctx context.Context, d *schema.ResourceData, config *ProviderConfig, wait time.Duration) error {
+	return resource.RetryContext(ctx, wait, func() *resource.RetryError {
 		asg, err := getClassicAutoScalingGroup(config, d.Id())
 		asgServerInstanceList, err := getClassicInAutoScalingGroupServerInstanceList(config, d.Id())
 		if err != nil {
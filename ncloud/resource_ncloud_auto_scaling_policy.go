@@ -1,9 +1,12 @@
 package ncloud
 
 import (
+	"context"
+
 	"github.com/NaverCloudPlatform/ncloud-sdk-go-v2/ncloud"
 	"github.com/NaverCloudPlatform/ncloud-sdk-go-v2/services/autoscaling"
 	"github.com/NaverCloudPlatform/ncloud-sdk-go-v2/services/vautoscaling"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 )
@@ -14,10 +17,10 @@ func init() {
 
 func resourceNcloudAutoScalingPolicy() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceNcloudAutoScalingPolicyCreate,
-		Read:   resourceNcloudAutoScalingPolicyRead,
-		Update: resourceNcloudAutoScalingPolicyUpdate,
-		Delete: resourceNcloudAutoScalingPolicyDelete,
+		CreateContext: resourceNcloudAutoScalingPolicyCreate,
+		ReadContext:   resourceNcloudAutoScalingPolicyRead,
+		UpdateContext: resourceNcloudAutoScalingPolicyUpdate,
+		DeleteContext: resourceNcloudAutoScalingPolicyDelete,
 		Importer: &schema.ResourceImporter{
 			StateContext: schema.ImportStatePassthroughContext,
 		},
@@ -54,17 +57,17 @@ func resourceNcloudAutoScalingPolicy() *schema.Resource {
 	}
 }
 
-func resourceNcloudAutoScalingPolicyCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceNcloudAutoScalingPolicyCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	config := meta.(*ProviderConfig)
 
 	autoscaling_group_no, id, err := createAutoScalingPolicy(d, config)
 	if err != nil {
-		return err
+		return ToDiagnostics(err)
 	}
 
 	d.SetId(ncloud.StringValue(id))
 	d.Set("auto_scaling_group_no", autoscaling_group_no)
-	return resourceNcloudAutoScalingPolicyRead(d, meta)
+	return resourceNcloudAutoScalingPolicyRead(ctx, d, meta)
 }
 
 func createAutoScalingPolicy(d *schema.ResourceData, config *ProviderConfig) (*string, *string, error) {
@@ -121,11 +124,11 @@ func createClassicAutoScalingPolicy(d *schema.ResourceData, config *ProviderConf
 	return ncloud.String(no), name, nil
 }
 
-func resourceNcloudAutoScalingPolicyRead(d *schema.ResourceData, meta interface{}) error {
+func resourceNcloudAutoScalingPolicyRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	config := meta.(*ProviderConfig)
 	policy, err := getAutoScalingPolicy(config, d.Id(), d.Get("auto_scaling_group_no").(string))
 	if err != nil {
-		return err
+		return ToDiagnostics(err)
 	}
 
 	if policy == nil {
@@ -202,19 +205,19 @@ func getClassicAutoScalingPolicy(config *ProviderConfig, id string, autoScalingG
 	}, nil
 }
 
-func resourceNcloudAutoScalingPolicyUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceNcloudAutoScalingPolicyUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	config := meta.(*ProviderConfig)
 	_, _, err := createAutoScalingPolicy(d, config)
 	if err != nil {
-		return err
+		return ToDiagnostics(err)
 	}
-	return resourceNcloudAutoScalingPolicyRead(d, meta)
+	return resourceNcloudAutoScalingPolicyRead(ctx, d, meta)
 }
 
-func resourceNcloudAutoScalingPolicyDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceNcloudAutoScalingPolicyDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	config := meta.(*ProviderConfig)
 	if err := deleteAutoScalingPolicy(config, d.Id(), d.Get("auto_scaling_group_no").(string)); err != nil {
-		return err
+		return ToDiagnostics(err)
 	}
 	return nil
 }
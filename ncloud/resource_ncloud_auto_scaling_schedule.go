@@ -1,9 +1,12 @@
 package ncloud
 
 import (
+	"context"
+
 	"github.com/NaverCloudPlatform/ncloud-sdk-go-v2/ncloud"
 	"github.com/NaverCloudPlatform/ncloud-sdk-go-v2/services/autoscaling"
 	"github.com/NaverCloudPlatform/ncloud-sdk-go-v2/services/vautoscaling"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
@@ -15,10 +18,10 @@ const SCHEDULE_TIME_FORMAT = "2006-01-02T15:04:05Z0700"
 
 func resourceNcloudAutoScalingSchedule() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceNcloudAutoScalingScheduleCreate,
-		Read:   resourceNcloudAutoScalingScheduleRead,
-		Update: resourceNcloudAutoScalingScheduleUpdate,
-		Delete: resourceNcloudAutoScalingScheduleDelete,
+		CreateContext: resourceNcloudAutoScalingScheduleCreate,
+		ReadContext:   resourceNcloudAutoScalingScheduleRead,
+		UpdateContext: resourceNcloudAutoScalingScheduleUpdate,
+		DeleteContext: resourceNcloudAutoScalingScheduleDelete,
 		Importer: &schema.ResourceImporter{
 			StateContext: schema.ImportStatePassthroughContext,
 		},
@@ -66,15 +69,15 @@ func resourceNcloudAutoScalingSchedule() *schema.Resource {
 	}
 }
 
-func resourceNcloudAutoScalingScheduleCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceNcloudAutoScalingScheduleCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	config := meta.(*ProviderConfig)
 	id, err := createAutoScalingSchedule(d, config)
 	if err != nil {
-		return err
+		return ToDiagnostics(err)
 	}
 
 	d.SetId(ncloud.StringValue(id))
-	return resourceNcloudAutoScalingScheduleRead(d, meta)
+	return resourceNcloudAutoScalingScheduleRead(ctx, d, meta)
 }
 
 func createAutoScalingSchedule(d *schema.ResourceData, config *ProviderConfig) (*string, error) {
@@ -135,11 +138,11 @@ func createClassicAutoScalingSchedule(d *schema.ResourceData, config *ProviderCo
 	return resp.ScheduledUpdateGroupActionList[0].ScheduledActionName, nil
 }
 
-func resourceNcloudAutoScalingScheduleRead(d *schema.ResourceData, meta interface{}) error {
+func resourceNcloudAutoScalingScheduleRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	config := meta.(*ProviderConfig)
 	schedule, err := getAutoScalingSchedule(config, d.Id(), d.Get("auto_scaling_group_no").(string))
 	if err != nil {
-		return err
+		return ToDiagnostics(err)
 	}
 
 	if schedule == nil {
@@ -221,18 +224,18 @@ func getClassicAutoScalingSchedule(config *ProviderConfig, id string, asgNo stri
 	}, nil
 }
 
-func resourceNcloudAutoScalingScheduleUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceNcloudAutoScalingScheduleUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	config := meta.(*ProviderConfig)
 	if _, err := createAutoScalingSchedule(d, config); err != nil {
-		return err
+		return ToDiagnostics(err)
 	}
-	return resourceNcloudAutoScalingScheduleRead(d, meta)
+	return resourceNcloudAutoScalingScheduleRead(ctx, d, meta)
 }
 
-func resourceNcloudAutoScalingScheduleDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceNcloudAutoScalingScheduleDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	config := meta.(*ProviderConfig)
 	if err := deleteAutoScalingSchedule(config, d.Id(), d.Get("auto_scaling_group_no").(string)); err != nil {
-		return err
+		return ToDiagnostics(err)
 	}
 	return nil
 }
@@ -1,6 +1,7 @@
 package ncloud
 
 import (
+	"context"
 	"fmt"
 	"time"
 
@@ -10,6 +11,7 @@ import (
 
 	"github.com/NaverCloudPlatform/ncloud-sdk-go-v2/ncloud"
 	"github.com/NaverCloudPlatform/ncloud-sdk-go-v2/services/server"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
@@ -27,12 +29,12 @@ const (
 
 func resourceNcloudBlockStorage() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceNcloudBlockStorageCreate,
-		Read:   resourceNcloudBlockStorageRead,
-		Update: resourceNcloudBlockStorageUpdate,
-		Delete: resourceNcloudBlockStorageDelete,
+		CreateContext: resourceNcloudBlockStorageCreate,
+		ReadContext:   resourceNcloudBlockStorageRead,
+		UpdateContext: resourceNcloudBlockStorageUpdate,
+		DeleteContext: resourceNcloudBlockStorageDelete,
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
 		},
 
 		Timeouts: &schema.ResourceTimeout{
@@ -41,6 +43,7 @@ func resourceNcloudBlockStorage() *schema.Resource {
 		},
 
 		Schema: map[string]*schema.Schema{
+			"platform": platformSchema(),
 			"server_instance_no": {
 				Type:     schema.TypeString,
 				Optional: true,
@@ -115,30 +118,36 @@ func resourceNcloudBlockStorage() *schema.Resource {
 	}
 }
 
-func resourceNcloudBlockStorageCreate(d *schema.ResourceData, meta interface{}) error {
-	config := meta.(*ProviderConfig)
+func resourceNcloudBlockStorageCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config, err := resourcePlatformConfig(d, meta)
+	if err != nil {
+		return ToDiagnostics(err)
+	}
 
 	if len(d.Get("server_instance_no").(string)) == 0 {
-		return fmt.Errorf("'server_instance_no' has to be present when ncloud_block_storage is first created.")
+		return diag.FromErr(fmt.Errorf("'server_instance_no' has to be present when ncloud_block_storage is first created."))
 	}
 
-	id, err := createBlockStorage(d, config)
+	id, err := createBlockStorage(ctx, d, config)
 	if err != nil {
-		return err
+		return ToDiagnostics(err)
 	}
 
 	d.SetId(ncloud.StringValue(id))
 	log.Printf("[INFO] Block Storage ID: %s", d.Id())
 
-	return resourceNcloudBlockStorageRead(d, meta)
+	return resourceNcloudBlockStorageRead(ctx, d, meta)
 }
 
-func resourceNcloudBlockStorageRead(d *schema.ResourceData, meta interface{}) error {
-	config := meta.(*ProviderConfig)
+func resourceNcloudBlockStorageRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config, err := resourcePlatformConfig(d, meta)
+	if err != nil {
+		return ToDiagnostics(err)
+	}
 
-	r, err := getBlockStorage(config, d.Id())
+	r, err := config.readBlockStorage(d.Id())
 	if err != nil {
-		return err
+		return ToDiagnostics(err)
 	}
 
 	if r == nil {
@@ -151,32 +160,38 @@ func resourceNcloudBlockStorageRead(d *schema.ResourceData, meta interface{}) er
 	SetSingularResourceDataFromMapSchema(resourceNcloudBlockStorage(), d, instance)
 
 	if err := d.Set("server_instance_no", r.ServerInstanceNo); err != nil {
-		return err
+		return ToDiagnostics(err)
 	}
 
 	return nil
 }
 
-func resourceNcloudBlockStorageDelete(d *schema.ResourceData, meta interface{}) error {
-	config := meta.(*ProviderConfig)
+func resourceNcloudBlockStorageDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config, err := resourcePlatformConfig(d, meta)
+	if err != nil {
+		return ToDiagnostics(err)
+	}
 
 	if d.Get("stop_instance_before_detaching").(bool) {
 		log.Printf("[INFO] Stopping Instance %s for destroying block storage", d.Get("server_instance_no").(string))
-		if err := stopThenWaitServerInstance(config, d.Get("server_instance_no").(string)); err != nil {
-			return err
+		if err := stopThenWaitServerInstance(ctx, config, d.Get("server_instance_no").(string)); err != nil {
+			return ToDiagnostics(err)
 		}
 	}
 
-	if err := deleteBlockStorage(d, config, d.Id()); err != nil {
-		return err
+	if err := deleteBlockStorage(ctx, config, d.Id()); err != nil {
+		return ToDiagnostics(err)
 	}
 
 	d.SetId("")
 	return nil
 }
 
-func resourceNcloudBlockStorageUpdate(d *schema.ResourceData, meta interface{}) error {
-	config := meta.(*ProviderConfig)
+func resourceNcloudBlockStorageUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config, err := resourcePlatformConfig(d, meta)
+	if err != nil {
+		return ToDiagnostics(err)
+	}
 
 	if d.HasChange("server_instance_no") {
 		o, n := d.GetChange("server_instance_no")
@@ -185,19 +200,19 @@ func resourceNcloudBlockStorageUpdate(d *schema.ResourceData, meta interface{})
 		if len(o.(string)) > 0 {
 			if d.Get("stop_instance_before_detaching").(bool) {
 				log.Printf("[INFO] Start Instance %s after detaching block storage", o.(string))
-				if err := stopThenWaitServerInstance(config, o.(string)); err != nil {
-					return err
+				if err := stopThenWaitServerInstance(ctx, config, o.(string)); err != nil {
+					return ToDiagnostics(err)
 				}
 			}
 
-			if err := detachBlockStorage(config, d.Id()); err != nil {
-				return err
+			if err := detachBlockStorage(ctx, config, d.Id()); err != nil {
+				return ToDiagnostics(err)
 			}
 		}
 
 		if len(n.(string)) > 0 {
-			if err := attachBlockStorage(d, config); err != nil {
-				return err
+			if err := attachBlockStorage(ctx, config, d.Id(), n.(string)); err != nil {
+				return ToDiagnostics(err)
 			}
 		}
 	}
@@ -206,52 +221,44 @@ func resourceNcloudBlockStorageUpdate(d *schema.ResourceData, meta interface{})
 		o, n := d.GetChange("size")
 
 		if o.(int) >= n.(int) {
-			return fmt.Errorf("The storage size is only expandable, not shrinking. new size(%d) must be greater than the existing size(%d)", n, o)
+			return diag.FromErr(fmt.Errorf("The storage size is only expandable, not shrinking. new size(%d) must be greater than the existing size(%d)", n, o))
 		}
 
 		// If server instance attached block storage, detach first
 		if len(d.Get("server_instance_no").(string)) > 0 {
 			if d.Get("stop_instance_before_detaching").(bool) {
 				log.Printf("[INFO] Start Instance %s after detaching block storage", d.Get("server_instance_no").(string))
-				if err := stopThenWaitServerInstance(config, d.Get("server_instance_no").(string)); err != nil {
-					return err
+				if err := stopThenWaitServerInstance(ctx, config, d.Get("server_instance_no").(string)); err != nil {
+					return ToDiagnostics(err)
 				}
 			}
 
-			if err := detachBlockStorage(config, d.Id()); err != nil {
-				return err
+			if err := detachBlockStorage(ctx, config, d.Id()); err != nil {
+				return ToDiagnostics(err)
 			}
 		}
 
-		if err := changeBlockStorageSize(d, config); err != nil {
-			return err
+		if err := changeBlockStorageSize(ctx, config, d.Id(), n.(int)); err != nil {
+			return ToDiagnostics(err)
 		}
 
 		if len(d.Get("server_instance_no").(string)) > 0 {
-			if err := attachBlockStorage(d, config); err != nil {
-				return err
+			if err := attachBlockStorage(ctx, config, d.Id(), d.Get("server_instance_no").(string)); err != nil {
+				return ToDiagnostics(err)
 			}
 		}
 	}
 
-	return resourceNcloudBlockStorageRead(d, meta)
+	return resourceNcloudBlockStorageRead(ctx, d, meta)
 }
 
-func createBlockStorage(d *schema.ResourceData, config *ProviderConfig) (*string, error) {
-	var id *string
-	var err error
-
-	if config.SupportVPC {
-		id, err = createVpcBlockStorage(d, config)
-	} else {
-		id, err = createClassicBlockStorage(d, config)
-	}
-
+func createBlockStorage(ctx context.Context, d *schema.ResourceData, config *ProviderConfig) (*string, error) {
+	id, err := config.BlockStorageService.Create(d)
 	if err != nil {
 		return nil, err
 	}
 
-	if err := waitForBlockStorageAttachment(config, *id); err != nil {
+	if err := waitForBlockStorageAttachment(ctx, config, *id); err != nil {
 		return nil, err
 	}
 
@@ -307,32 +314,33 @@ func createVpcBlockStorage(d *schema.ResourceData, config *ProviderConfig) (*str
 	return instance.BlockStorageInstanceNo, nil
 }
 
-func getBlockStorage(config *ProviderConfig, id string) (*BlockStorage, error) {
-	if config.SupportVPC {
-		return getVpcBlockStorage(config, id)
+func getClassicBlockStorage(config *ProviderConfig, id string) (*BlockStorage, error) {
+	instances, err := getClassicBlockStorageInstanceList(config, []string{id})
+	if err != nil || len(instances) == 0 {
+		return nil, err
 	}
 
-	return getClassicBlockStorage(config, id)
+	return instances[0], nil
 }
 
-func getClassicBlockStorage(config *ProviderConfig, id string) (*BlockStorage, error) {
+// getClassicBlockStorageInstanceList returns the block storages found of the instance numbers
+func getClassicBlockStorageInstanceList(config *ProviderConfig, ids []string) ([]*BlockStorage, error) {
 	reqParams := &server.GetBlockStorageInstanceListRequest{
-		BlockStorageInstanceNoList: ncloud.StringList([]string{id}),
+		BlockStorageInstanceNoList: ncloud.StringList(ids),
 	}
 
-	logCommonRequest("getClassicBlockStorage", reqParams)
+	logCommonRequest("getClassicBlockStorageInstanceList", reqParams)
 
 	resp, err := config.Client.server.V2Api.GetBlockStorageInstanceList(reqParams)
 	if err != nil {
-		logErrorResponse("getClassicBlockStorage", err, reqParams)
+		logErrorResponse("getClassicBlockStorageInstanceList", err, reqParams)
 		return nil, err
 	}
-	logResponse("getClassicBlockStorage", resp)
+	logResponse("getClassicBlockStorageInstanceList", resp)
 
-	if len(resp.BlockStorageInstanceList) > 0 {
-		inst := resp.BlockStorageInstanceList[0]
-
-		return &BlockStorage{
+	instances := make([]*BlockStorage, 0, len(resp.BlockStorageInstanceList))
+	for _, inst := range resp.BlockStorageInstanceList {
+		instances = append(instances, &BlockStorage{
 			BlockStorageInstanceNo:  inst.BlockStorageInstanceNo,
 			ServerInstanceNo:        inst.ServerInstanceNo,
 			ServerName:              inst.ServerName,
@@ -346,31 +354,40 @@ func getClassicBlockStorage(config *ProviderConfig, id string) (*BlockStorage, e
 			Description:             inst.BlockStorageInstanceDescription,
 			DiskType:                inst.DiskType.Code,
 			DiskDetailType:          inst.DiskDetailType.Code,
-		}, nil
+		})
 	}
 
-	return nil, nil
+	return instances, nil
 }
 
 func getVpcBlockStorage(config *ProviderConfig, id string) (*BlockStorage, error) {
-	reqParams := &vserver.GetBlockStorageInstanceDetailRequest{
-		RegionCode:             &config.RegionCode,
-		BlockStorageInstanceNo: ncloud.String(id),
+	instances, err := getVpcBlockStorageInstanceList(config, []string{id})
+	if err != nil || len(instances) == 0 {
+		return nil, err
 	}
 
-	logCommonRequest("getVpcBlockStorage", reqParams)
+	return instances[0], nil
+}
 
-	resp, err := config.Client.vserver.V2Api.GetBlockStorageInstanceDetail(reqParams)
+// getVpcBlockStorageInstanceList returns the block storages found of the instance numbers
+func getVpcBlockStorageInstanceList(config *ProviderConfig, ids []string) ([]*BlockStorage, error) {
+	reqParams := &vserver.GetBlockStorageInstanceListRequest{
+		RegionCode:                 &config.RegionCode,
+		BlockStorageInstanceNoList: ncloud.StringList(ids),
+	}
+
+	logCommonRequest("getVpcBlockStorageInstanceList", reqParams)
+
+	resp, err := config.Client.vserver.V2Api.GetBlockStorageInstanceList(reqParams)
 	if err != nil {
-		logErrorResponse("getVpcBlockStorage", err, reqParams)
+		logErrorResponse("getVpcBlockStorageInstanceList", err, reqParams)
 		return nil, err
 	}
-	logResponse("getVpcBlockStorage", resp)
+	logResponse("getVpcBlockStorageInstanceList", resp)
 
-	if len(resp.BlockStorageInstanceList) > 0 {
-		inst := resp.BlockStorageInstanceList[0]
-
-		return &BlockStorage{
+	instances := make([]*BlockStorage, 0, len(resp.BlockStorageInstanceList))
+	for _, inst := range resp.BlockStorageInstanceList {
+		instances = append(instances, &BlockStorage{
 			BlockStorageInstanceNo:  inst.BlockStorageInstanceNo,
 			ServerInstanceNo:        inst.ServerInstanceNo,
 			BlockStorageType:        inst.BlockStorageType.Code,
@@ -384,23 +401,14 @@ func getVpcBlockStorage(config *ProviderConfig, id string) (*BlockStorage, error
 			DiskType:                inst.BlockStorageDiskType.Code,
 			DiskDetailType:          inst.BlockStorageDiskDetailType.Code,
 			ZoneCode:                inst.ZoneCode,
-		}, nil
+		})
 	}
 
-	return nil, nil
+	return instances, nil
 }
 
-func deleteBlockStorage(d *schema.ResourceData, config *ProviderConfig, id string) error {
-
-	var err error
-
-	if config.SupportVPC {
-		err = deleteVpcBlockStorage(d, config, id)
-	} else {
-		err = deleteClassicBlockStorage(d, config, id)
-	}
-
-	if err != nil {
+func deleteBlockStorage(ctx context.Context, config *ProviderConfig, id string) error {
+	if err := config.BlockStorageService.Delete(id); err != nil {
 		return err
 	}
 
@@ -408,7 +416,7 @@ func deleteBlockStorage(d *schema.ResourceData, config *ProviderConfig, id strin
 		Pending: []string{BlockStorageStatusCodeInit, BlockStorageStatusCodeAttach},
 		Target:  []string{"TERMINATED"},
 		Refresh: func() (interface{}, string, error) {
-			instance, err := getBlockStorage(config, id)
+			instance, err := config.BlockStorageService.Get(id)
 			if err != nil {
 				return 0, "", err
 			}
@@ -422,7 +430,7 @@ func deleteBlockStorage(d *schema.ResourceData, config *ProviderConfig, id strin
 		MinTimeout: 3 * time.Second,
 	}
 
-	_, err = stateConf.WaitForState()
+	_, err := stateConf.WaitForStateContext(ctx)
 	if err != nil {
 		return fmt.Errorf("error waiting for BlockStorageInstance state to be \"TERMINATED\": %s", err)
 	}
@@ -430,7 +438,7 @@ func deleteBlockStorage(d *schema.ResourceData, config *ProviderConfig, id strin
 	return nil
 }
 
-func deleteClassicBlockStorage(d *schema.ResourceData, config *ProviderConfig, id string) error {
+func deleteClassicBlockStorage(config *ProviderConfig, id string) error {
 	reqParams := server.DeleteBlockStorageInstancesRequest{
 		BlockStorageInstanceNoList: []*string{ncloud.String(id)},
 	}
@@ -446,7 +454,7 @@ func deleteClassicBlockStorage(d *schema.ResourceData, config *ProviderConfig, i
 	return nil
 }
 
-func deleteVpcBlockStorage(d *schema.ResourceData, config *ProviderConfig, id string) error {
+func deleteVpcBlockStorage(config *ProviderConfig, id string) error {
 	reqParams := vserver.DeleteBlockStorageInstancesRequest{
 		RegionCode:                 &config.RegionCode,
 		BlockStorageInstanceNoList: []*string{ncloud.String(id)},
@@ -463,20 +471,12 @@ func deleteVpcBlockStorage(d *schema.ResourceData, config *ProviderConfig, id st
 	return nil
 }
 
-func detachBlockStorage(config *ProviderConfig, id string) error {
-	var err error
-
-	if config.SupportVPC {
-		err = detachVpcBlockStorage(config, id)
-	} else {
-		err = detachClassicBlockStorage(config, id)
-	}
-
-	if err != nil {
+func detachBlockStorage(ctx context.Context, config *ProviderConfig, id string) error {
+	if err := config.BlockStorageService.Detach(id); err != nil {
 		return err
 	}
 
-	if err = waitForBlockStorageDetachment(config, id); err != nil {
+	if err := waitForBlockStorageDetachment(ctx, config, id); err != nil {
 		return err
 	}
 
@@ -517,12 +517,12 @@ func detachVpcBlockStorage(config *ProviderConfig, id string) error {
 	return nil
 }
 
-func waitForBlockStorageDetachment(config *ProviderConfig, id string) error {
+func waitForBlockStorageDetachment(ctx context.Context, config *ProviderConfig, id string) error {
 	stateConf := &resource.StateChangeConf{
 		Pending: []string{BlockStorageStatusCodeAttach},
 		Target:  []string{BlockStorageStatusCodeCreate},
 		Refresh: func() (interface{}, string, error) {
-			instance, err := getBlockStorage(config, id)
+			instance, err := config.BlockStorageService.Get(id)
 			if err != nil {
 				return 0, "", err
 			}
@@ -533,7 +533,7 @@ func waitForBlockStorageDetachment(config *ProviderConfig, id string) error {
 		MinTimeout: 3 * time.Second,
 	}
 
-	_, err := stateConf.WaitForState()
+	_, err := stateConf.WaitForStateContext(ctx)
 	if err != nil {
 		return fmt.Errorf("error waiting for BlockStorageInstance state to be \"CREAT\": %s", err)
 	}
@@ -541,29 +541,22 @@ func waitForBlockStorageDetachment(config *ProviderConfig, id string) error {
 	return nil
 }
 
-func attachBlockStorage(d *schema.ResourceData, config *ProviderConfig) error {
-	var err error
-	if config.SupportVPC {
-		err = attachVpcBlockStorage(d, config)
-	} else {
-		err = attachClassicBlockStorage(d, config)
-	}
-
-	if err != nil {
+func attachBlockStorage(ctx context.Context, config *ProviderConfig, id string, serverInstanceNo string) error {
+	if err := config.BlockStorageService.Attach(id, serverInstanceNo); err != nil {
 		return err
 	}
 
-	if err = waitForBlockStorageAttachment(config, d.Id()); err != nil {
+	if err := waitForBlockStorageAttachment(ctx, config, id); err != nil {
 		return err
 	}
 
 	return nil
 }
 
-func attachClassicBlockStorage(d *schema.ResourceData, config *ProviderConfig) error {
+func attachClassicBlockStorage(config *ProviderConfig, id string, serverInstanceNo string) error {
 	reqParams := &server.AttachBlockStorageInstanceRequest{
-		ServerInstanceNo:       ncloud.String(d.Get("server_instance_no").(string)),
-		BlockStorageInstanceNo: ncloud.String(d.Id()),
+		ServerInstanceNo:       ncloud.String(serverInstanceNo),
+		BlockStorageInstanceNo: ncloud.String(id),
 	}
 
 	logCommonRequest("attachClassicBlockStorage", reqParams)
@@ -578,10 +571,10 @@ func attachClassicBlockStorage(d *schema.ResourceData, config *ProviderConfig) e
 	return nil
 }
 
-func attachVpcBlockStorage(d *schema.ResourceData, config *ProviderConfig) error {
+func attachVpcBlockStorage(config *ProviderConfig, id string, serverInstanceNo string) error {
 	reqParams := &vserver.AttachBlockStorageInstanceRequest{
-		ServerInstanceNo:       ncloud.String(d.Get("server_instance_no").(string)),
-		BlockStorageInstanceNo: ncloud.String(d.Id()),
+		ServerInstanceNo:       ncloud.String(serverInstanceNo),
+		BlockStorageInstanceNo: ncloud.String(id),
 	}
 
 	logCommonRequest("attachVpcBlockStorage", reqParams)
@@ -596,12 +589,12 @@ func attachVpcBlockStorage(d *schema.ResourceData, config *ProviderConfig) error
 	return nil
 }
 
-func waitForBlockStorageAttachment(config *ProviderConfig, id string) error {
+func waitForBlockStorageAttachment(ctx context.Context, config *ProviderConfig, id string) error {
 	stateConf := &resource.StateChangeConf{
 		Pending: []string{BlockStorageStatusCodeInit, BlockStorageStatusCodeCreate},
 		Target:  []string{BlockStorageStatusCodeAttach},
 		Refresh: func() (interface{}, string, error) {
-			instance, err := getBlockStorage(config, id)
+			instance, err := config.BlockStorageService.Get(id)
 			if err != nil {
 				return 0, "", err
 			}
@@ -612,7 +605,7 @@ func waitForBlockStorageAttachment(config *ProviderConfig, id string) error {
 		MinTimeout: 3 * time.Second,
 	}
 
-	_, err := stateConf.WaitForState()
+	_, err := stateConf.WaitForStateContext(ctx)
 	if err != nil {
 		return fmt.Errorf("error waiting for BlockStorageInstance state to be \"ATTAC\": %s", err)
 	}
@@ -620,30 +613,23 @@ func waitForBlockStorageAttachment(config *ProviderConfig, id string) error {
 	return nil
 }
 
-func changeBlockStorageSize(d *schema.ResourceData, config *ProviderConfig) error {
-	var err error
-	if config.SupportVPC {
-		err = changeVpcBlockStorageSize(d, config)
-	} else {
-		err = changeClassicBlockStorageSize(d, config)
-	}
-
-	if err != nil {
+func changeBlockStorageSize(ctx context.Context, config *ProviderConfig, id string, size int) error {
+	if err := config.BlockStorageService.ChangeSize(id, size); err != nil {
 		return err
 	}
 
-	if err = waitForBlockStorageOperationIsNull(config, d.Id()); err != nil {
+	if err := waitForBlockStorageOperationIsNull(ctx, config, id); err != nil {
 		return err
 	}
 
 	return nil
 }
 
-func changeVpcBlockStorageSize(d *schema.ResourceData, config *ProviderConfig) error {
+func changeVpcBlockStorageSize(config *ProviderConfig, id string, size int) error {
 	reqParams := &vserver.ChangeBlockStorageVolumeSizeRequest{
 		RegionCode:             &config.RegionCode,
-		BlockStorageInstanceNo: ncloud.String(d.Id()),
-		BlockStorageSize:       ncloud.Int32(int32(d.Get("size").(int))),
+		BlockStorageInstanceNo: ncloud.String(id),
+		BlockStorageSize:       ncloud.Int32(int32(size)),
 	}
 
 	logCommonRequest("changeVpcBlockStorageSize", reqParams)
@@ -657,10 +643,10 @@ func changeVpcBlockStorageSize(d *schema.ResourceData, config *ProviderConfig) e
 	return nil
 }
 
-func changeClassicBlockStorageSize(d *schema.ResourceData, config *ProviderConfig) error {
+func changeClassicBlockStorageSize(config *ProviderConfig, id string, size int) error {
 	reqParams := &server.ChangeBlockStorageVolumeSizeRequest{
-		BlockStorageInstanceNo: ncloud.String(d.Id()),
-		BlockStorageSize:       ncloud.Int64(int64(d.Get("size").(int))),
+		BlockStorageInstanceNo: ncloud.String(id),
+		BlockStorageSize:       ncloud.Int64(int64(size)),
 	}
 
 	logCommonRequest("changeClassicBlockStorageSize", reqParams)
@@ -674,12 +660,12 @@ func changeClassicBlockStorageSize(d *schema.ResourceData, config *ProviderConfi
 	return nil
 }
 
-func waitForBlockStorageOperationIsNull(config *ProviderConfig, id string) error {
+func waitForBlockStorageOperationIsNull(ctx context.Context, config *ProviderConfig, id string) error {
 	stateConf := &resource.StateChangeConf{
 		Pending: []string{"CHNG"},
 		Target:  []string{"NULL"},
 		Refresh: func() (interface{}, string, error) {
-			instance, err := getBlockStorage(config, id)
+			instance, err := config.BlockStorageService.Get(id)
 			if err != nil {
 				return 0, "", err
 			}
@@ -690,7 +676,7 @@ func waitForBlockStorageOperationIsNull(config *ProviderConfig, id string) error
 		MinTimeout: 3 * time.Second,
 	}
 
-	_, err := stateConf.WaitForState()
+	_, err := stateConf.WaitForStateContext(ctx)
 	if err != nil {
 		return fmt.Errorf("error waiting for BlockStorageInstance operation to be \"NULL\": %s", err)
 	}
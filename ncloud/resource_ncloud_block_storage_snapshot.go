@@ -1,6 +1,7 @@
 package ncloud
 
 import (
+	"context"
 	"fmt"
 	"time"
 
@@ -8,6 +9,7 @@ import (
 
 	"github.com/NaverCloudPlatform/ncloud-sdk-go-v2/ncloud"
 	"github.com/NaverCloudPlatform/ncloud-sdk-go-v2/services/server"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
@@ -18,12 +20,12 @@ func init() {
 
 func resourceNcloudBlockStorageSnapshot() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceNcloudBlockStorageSnapshotCreate,
-		Read:   resourceNcloudBlockStorageSnapshotRead,
-		Update: resourceNcloudBlockStorageSnapshotUpdate,
-		Delete: resourceNcloudBlockStorageSnapshotDelete,
+		CreateContext: resourceNcloudBlockStorageSnapshotCreate,
+		ReadContext:   resourceNcloudBlockStorageSnapshotRead,
+		UpdateContext: resourceNcloudBlockStorageSnapshotUpdate,
+		DeleteContext: resourceNcloudBlockStorageSnapshotDelete,
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
 		},
 
 		Timeouts: &schema.ResourceTimeout{
@@ -107,13 +109,13 @@ func resourceNcloudBlockStorageSnapshot() *schema.Resource {
 	}
 }
 
-func resourceNcloudBlockStorageSnapshotCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceNcloudBlockStorageSnapshotCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	client := meta.(*ProviderConfig).Client
 
 	config := meta.(*ProviderConfig)
 
 	if config.SupportVPC {
-		return NotSupportVpc("resource `ncloud_block_storage_snapshot`")
+		return diag.FromErr(NotSupportVpc("resource `ncloud_block_storage_snapshot`"))
 	}
 
 	reqParams := buildRequestBlockStorageSnapshotInstance(d)
@@ -122,7 +124,7 @@ func resourceNcloudBlockStorageSnapshotCreate(d *schema.ResourceData, meta inter
 	resp, err := client.server.V2Api.CreateBlockStorageSnapshotInstance(reqParams)
 	if err != nil {
 		logErrorResponse("CreateBlockStorageSnapshotInstance", err, reqParams)
-		return err
+		return ToDiagnostics(err)
 	}
 	logCommonResponse("CreateBlockStorageSnapshotInstance", GetCommonResponse(resp))
 
@@ -145,19 +147,19 @@ func resourceNcloudBlockStorageSnapshotCreate(d *schema.ResourceData, meta inter
 		MinTimeout: 3 * time.Second,
 	}
 
-	_, err = stateConf.WaitForState()
+	_, err = stateConf.WaitForStateContext(ctx)
 	if err != nil {
-		return fmt.Errorf("Error waiting for BlockStorageSnapshotInstance state to be \"CREAT\": %s", err)
+		return diag.FromErr(fmt.Errorf("Error waiting for BlockStorageSnapshotInstance state to be \"CREAT\": %s", err))
 	}
 
-	return resourceNcloudBlockStorageSnapshotRead(d, meta)
+	return resourceNcloudBlockStorageSnapshotRead(ctx, d, meta)
 }
 
-func resourceNcloudBlockStorageSnapshotRead(d *schema.ResourceData, meta interface{}) error {
+func resourceNcloudBlockStorageSnapshotRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	client := meta.(*ProviderConfig).Client
 	snapshot, err := getBlockStorageSnapshotInstance(client, d.Id())
 	if err != nil {
-		return err
+		return ToDiagnostics(err)
 	}
 
 	if snapshot != nil {
@@ -185,15 +187,15 @@ func resourceNcloudBlockStorageSnapshotRead(d *schema.ResourceData, meta interfa
 	return nil
 }
 
-func resourceNcloudBlockStorageSnapshotUpdate(d *schema.ResourceData, meta interface{}) error {
-	return resourceNcloudBlockStorageSnapshotRead(d, meta)
+func resourceNcloudBlockStorageSnapshotUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	return resourceNcloudBlockStorageSnapshotRead(ctx, d, meta)
 }
 
-func resourceNcloudBlockStorageSnapshotDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceNcloudBlockStorageSnapshotDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	client := meta.(*ProviderConfig).Client
 	blockStorageSnapshotInstanceNo := d.Get("instance_no").(string)
-	if err := deleteBlockStorageSnapshotInstance(client, blockStorageSnapshotInstanceNo); err != nil {
-		return err
+	if err := deleteBlockStorageSnapshotInstance(ctx, client, blockStorageSnapshotInstanceNo); err != nil {
+		return ToDiagnostics(err)
 	}
 	d.SetId("")
 	return nil
@@ -244,7 +246,7 @@ func getBlockStorageSnapshotInstance(client *NcloudAPIClient, blockStorageSnapsh
 	return nil, nil
 }
 
-func deleteBlockStorageSnapshotInstance(client *NcloudAPIClient, blockStorageSnapshotInstanceNo string) error {
+func deleteBlockStorageSnapshotInstance(ctx context.Context, client *NcloudAPIClient, blockStorageSnapshotInstanceNo string) error {
 	reqParams := server.DeleteBlockStorageSnapshotInstancesRequest{
 		BlockStorageSnapshotInstanceNoList: []*string{ncloud.String(blockStorageSnapshotInstanceNo)},
 	}
@@ -281,7 +283,7 @@ func deleteBlockStorageSnapshotInstance(client *NcloudAPIClient, blockStorageSna
 		MinTimeout: 3 * time.Second,
 	}
 
-	_, err = stateConf.WaitForState()
+	_, err = stateConf.WaitForStateContext(ctx)
 	if err != nil {
 		return fmt.Errorf("Error waiting for BlockStorageSnapshotInstance state to be \"TERMT\": %s", err)
 	}
@@ -254,7 +254,7 @@ func testAccCheckBlockStorageExistsWithProvider(n string, i *BlockStorage, provi
 		}
 
 		config := provider.Meta().(*ProviderConfig)
-		storage, err := getBlockStorage(config, rs.Primary.ID)
+		storage, err := config.BlockStorageService.Get(rs.Primary.ID)
 		if err != nil {
 			return nil
 		}
@@ -275,7 +275,7 @@ func testAccCheckBlockStorageDestroyWithProvider(s *terraform.State, provider *s
 		if rs.Type != "ncloud_block_storage" {
 			continue
 		}
-		blockStorage, err := getBlockStorage(config, rs.Primary.ID)
+		blockStorage, err := config.BlockStorageService.Get(rs.Primary.ID)
 
 		if blockStorage == nil {
 			continue
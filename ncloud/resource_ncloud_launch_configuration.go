@@ -1,11 +1,13 @@
 package ncloud
 
 import (
+	"context"
 	"fmt"
 
 	"github.com/NaverCloudPlatform/ncloud-sdk-go-v2/ncloud"
 	"github.com/NaverCloudPlatform/ncloud-sdk-go-v2/services/autoscaling"
 	"github.com/NaverCloudPlatform/ncloud-sdk-go-v2/services/vautoscaling"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
@@ -15,13 +17,14 @@ func init() {
 
 func resourceNcloudLaunchConfiguration() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceNcloudLaunchConfigurationCreate,
-		Read:   resourceNcloudLaunchConfigurationRead,
-		Delete: resourceNcloudLaunchConfigurationDelete,
+		CreateContext: resourceNcloudLaunchConfigurationCreate,
+		ReadContext:   resourceNcloudLaunchConfigurationRead,
+		DeleteContext: resourceNcloudLaunchConfigurationDelete,
 		Importer: &schema.ResourceImporter{
 			StateContext: schema.ImportStatePassthroughContext,
 		},
 		Schema: map[string]*schema.Schema{
+			"platform": platformSchema(),
 			"launch_configuration_no": {
 				Type:     schema.TypeString,
 				Computed: true,
@@ -84,15 +87,18 @@ func resourceNcloudLaunchConfiguration() *schema.Resource {
 	}
 }
 
-func resourceNcloudLaunchConfigurationCreate(d *schema.ResourceData, meta interface{}) error {
-	config := meta.(*ProviderConfig)
+func resourceNcloudLaunchConfigurationCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config, err := resourcePlatformConfig(d, meta)
+	if err != nil {
+		return ToDiagnostics(err)
+	}
 	id, err := createLaunchConfiguration(d, config)
 	if err != nil {
-		return err
+		return ToDiagnostics(err)
 	}
 
 	d.SetId(ncloud.StringValue(id))
-	return resourceNcloudLaunchConfigurationRead(d, meta)
+	return resourceNcloudLaunchConfigurationRead(ctx, d, meta)
 }
 
 func createLaunchConfiguration(d *schema.ResourceData, config *ProviderConfig) (*string, error) {
@@ -126,6 +132,11 @@ func createVpcLaunchConfiguration(d *schema.ResourceData, config *ProviderConfig
 }
 
 func createClassicLaunchConfiguration(d *schema.ResourceData, config *ProviderConfig) (*string, error) {
+	regionNo, err := config.regionNo()
+	if err != nil {
+		return nil, err
+	}
+
 	reqParams := &autoscaling.CreateLaunchConfigurationRequest{
 		LaunchConfigurationName: StringPtrOrNil(d.GetOk("name")),
 		ServerImageProductCode:  StringPtrOrNil(d.GetOk("server_image_product_code")),
@@ -133,7 +144,7 @@ func createClassicLaunchConfiguration(d *schema.ResourceData, config *ProviderCo
 		MemberServerImageNo:     StringPtrOrNil(d.GetOk("member_server_image_no")),
 		LoginKeyName:            StringPtrOrNil(d.GetOk("login_key_name")),
 		UserData:                StringPtrOrNil(d.GetOk("user_data")),
-		RegionNo:                &config.RegionNo,
+		RegionNo:                regionNo,
 	}
 
 	if param, ok := d.GetOk("access_control_group_no_list"); ok {
@@ -150,12 +161,15 @@ func createClassicLaunchConfiguration(d *schema.ResourceData, config *ProviderCo
 	return res.LaunchConfigurationList[0].LaunchConfigurationNo, nil
 }
 
-func resourceNcloudLaunchConfigurationRead(d *schema.ResourceData, meta interface{}) error {
-	config := meta.(*ProviderConfig)
+func resourceNcloudLaunchConfigurationRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config, err := resourcePlatformConfig(d, meta)
+	if err != nil {
+		return ToDiagnostics(err)
+	}
 
 	launchConfig, err := getLaunchConfiguration(config, d.Id())
 	if err != nil {
-		return err
+		return ToDiagnostics(err)
 	}
 
 	if launchConfig == nil {
@@ -215,8 +229,13 @@ func getVpcLaunchConfiguration(config *ProviderConfig, id string) (*LaunchConfig
 
 func getClassicLaunchConfiguration(config *ProviderConfig, id string) (*LaunchConfiguration, error) {
 	no := ncloud.String(id)
+	regionNo, err := config.regionNo()
+	if err != nil {
+		return nil, err
+	}
+
 	reqParams := &autoscaling.GetLaunchConfigurationListRequest{
-		RegionNo: &config.RegionNo,
+		RegionNo: regionNo,
 	}
 	logCommonRequest("getClassicLaunchConfiguration", reqParams)
 	resp, err := config.Client.autoscaling.V2Api.GetLaunchConfigurationList(reqParams)
@@ -244,12 +263,14 @@ func getClassicLaunchConfiguration(config *ProviderConfig, id string) (*LaunchCo
 	return nil, nil
 }
 
-func resourceNcloudLaunchConfigurationDelete(d *schema.ResourceData, meta interface{}) error {
-	config := meta.(*ProviderConfig)
-
-	err := deleteLaunchConfiguration(config, d.Id())
+func resourceNcloudLaunchConfigurationDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config, err := resourcePlatformConfig(d, meta)
 	if err != nil {
-		return err
+		return ToDiagnostics(err)
+	}
+
+	if err := deleteLaunchConfiguration(config, d.Id()); err != nil {
+		return ToDiagnostics(err)
 	}
 
 	return nil
@@ -304,8 +325,13 @@ func deleteClassicLaunchConfiguration(config *ProviderConfig, id string) error {
 }
 
 func getClassicLaunchConfigurationByNo(no *string, config *ProviderConfig) (*LaunchConfiguration, error) {
+	regionNo, err := config.regionNo()
+	if err != nil {
+		return nil, err
+	}
+
 	reqParams := &autoscaling.GetLaunchConfigurationListRequest{
-		RegionNo: &config.RegionNo,
+		RegionNo: regionNo,
 	}
 	resp, err := config.Client.autoscaling.V2Api.GetLaunchConfigurationList(reqParams)
 	if err != nil {
@@ -7,7 +7,6 @@ import (
 	"github.com/NaverCloudPlatform/ncloud-sdk-go-v2/services/vloadbalancer"
 	"github.com/NaverCloudPlatform/ncloud-sdk-go-v2/services/vpc"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"time"
@@ -134,7 +133,7 @@ func resourceNcloudLbCreate(ctx context.Context, d *schema.ResourceData, meta in
 	for _, subnetNo := range reqParams.SubnetNoList {
 		subnet, err := getSubnetInstance(config, *subnetNo)
 		if err != nil {
-			return diag.FromErr(err)
+			return ToDiagnostics(err)
 		}
 		if subnet == nil {
 			return diag.FromErr(fmt.Errorf("not found subnet(%s)", *subnetNo))
@@ -153,11 +152,11 @@ func resourceNcloudLbCreate(ctx context.Context, d *schema.ResourceData, meta in
 	resp, err := config.Client.vloadbalancer.V2Api.CreateLoadBalancerInstance(reqParams)
 	if err != nil {
 		logErrorResponse("resourceNcloudLbCreate", err, reqParams)
-		return diag.FromErr(err)
+		return ToDiagnostics(err)
 	}
 	logResponse("resourceNcloudLbCreate", resp)
 	if err := waitForLoadBalancerActive(ctx, d, config, ncloud.StringValue(resp.LoadBalancerInstanceList[0].LoadBalancerInstanceNo)); err != nil {
-		return diag.FromErr(err)
+		return ToDiagnostics(err)
 	}
 	d.SetId(ncloud.StringValue(resp.LoadBalancerInstanceList[0].LoadBalancerInstanceNo))
 	return resourceNcloudLbRead(ctx, d, meta)
@@ -172,7 +171,7 @@ func resourceNcloudLbRead(ctx context.Context, d *schema.ResourceData, meta inte
 	lb, err := getVpcLoadBalancer(config, d.Id())
 
 	if err != nil {
-		return diag.FromErr(err)
+		return ToDiagnostics(err)
 	}
 
 	if lb == nil {
@@ -192,7 +191,7 @@ func resourceNcloudLbUpdate(ctx context.Context, d *schema.ResourceData, meta in
 	}
 	if d.HasChanges("idle_timeout", "throughput_type") {
 		if err := waitForLoadBalancerActive(ctx, d, config, d.Id()); err != nil {
-			return diag.FromErr(err)
+			return ToDiagnostics(err)
 		}
 		_, err := config.Client.vloadbalancer.V2Api.ChangeLoadBalancerInstanceConfiguration(&vloadbalancer.ChangeLoadBalancerInstanceConfigurationRequest{
 			RegionCode:             &config.RegionCode,
@@ -201,13 +200,13 @@ func resourceNcloudLbUpdate(ctx context.Context, d *schema.ResourceData, meta in
 			ThroughputTypeCode:     StringPtrOrNil(d.GetOk("throughput_type")),
 		})
 		if err != nil {
-			return diag.FromErr(err)
+			return ToDiagnostics(err)
 		}
 	}
 
 	if d.HasChanges("description") {
 		if err := waitForLoadBalancerActive(ctx, d, config, d.Id()); err != nil {
-			return diag.FromErr(err)
+			return ToDiagnostics(err)
 		}
 		_, err := config.Client.vloadbalancer.V2Api.SetLoadBalancerDescription(&vloadbalancer.SetLoadBalancerDescriptionRequest{
 			RegionCode:              &config.RegionCode,
@@ -215,7 +214,7 @@ func resourceNcloudLbUpdate(ctx context.Context, d *schema.ResourceData, meta in
 			LoadBalancerDescription: StringPtrOrNil(d.GetOk("description")),
 		})
 		if err != nil {
-			return diag.FromErr(err)
+			return ToDiagnostics(err)
 		}
 	}
 	return resourceNcloudLbRead(ctx, d, config)
@@ -232,85 +231,68 @@ func resourceNcloudLbDelete(ctx context.Context, d *schema.ResourceData, meta in
 	}
 
 	if err := waitForLoadBalancerActive(ctx, d, config, d.Id()); err != nil {
-		return diag.FromErr(err)
+		return ToDiagnostics(err)
 	}
 
 	logCommonRequest("resourceNcloudLbDelete", deleteInstanceReqParams)
 	if _, err := config.Client.vloadbalancer.V2Api.DeleteLoadBalancerInstances(deleteInstanceReqParams); err != nil {
 		logErrorResponse("resourceNcloudLbDelete", err, deleteInstanceReqParams)
-		return diag.FromErr(err)
+		return ToDiagnostics(err)
 	}
 
 	if err := waitForLoadBalancerDeletion(ctx, d, config); err != nil {
-		return diag.FromErr(err)
+		return ToDiagnostics(err)
 	}
 
 	return nil
 }
 
 func waitForLoadBalancerDeletion(ctx context.Context, d *schema.ResourceData, config *ProviderConfig) error {
-	stateConf := &resource.StateChangeConf{
+	waiter := &Waiter[vloadbalancer.LoadBalancerInstance]{
+		Name:    fmt.Sprintf("Load Balancer instance (%s)", d.Id()),
 		Pending: []string{LoadBalancerInstanceOperationTerminateCode},
 		Target:  []string{LoadBalancerInstanceOperationNullCode},
-		Refresh: func() (result interface{}, state string, err error) {
-			reqParams := &vloadbalancer.GetLoadBalancerInstanceDetailRequest{
-				RegionCode:             &config.RegionCode,
-				LoadBalancerInstanceNo: ncloud.String(d.Id()),
-			}
-			resp, err := config.Client.vloadbalancer.V2Api.GetLoadBalancerInstanceDetail(reqParams)
-			if err != nil {
-				return nil, "", err
-			}
-
-			if len(resp.LoadBalancerInstanceList) < 1 {
-				return resp, LoadBalancerInstanceOperationNullCode, nil
-			}
-
-			lb := resp.LoadBalancerInstanceList[0]
-			return resp, ncloud.StringValue(lb.LoadBalancerInstanceOperation.Code), nil
+		Refresh: func() (*vloadbalancer.LoadBalancerInstance, error) {
+			return getVpcLoadBalancerInstance(config, d.Id())
 		},
-		Timeout:    d.Timeout(schema.TimeoutDelete),
-		MinTimeout: 3 * time.Second,
-		Delay:      2 * time.Second,
+		Status: func(lb *vloadbalancer.LoadBalancerInstance) string {
+			return commonCodeValue(lb.LoadBalancerInstanceOperation)
+		},
+		NotFoundStatus: LoadBalancerInstanceOperationNullCode,
+		Timeout:        d.Timeout(schema.TimeoutDelete),
+		Delay:          2 * time.Second,
 	}
-	if _, err := stateConf.WaitForStateContext(ctx); err != nil {
+	if _, err := waiter.Wait(ctx); err != nil {
 		return fmt.Errorf("Error waiting for Load Balancer instance (%s) to become terminating: %s", d.Id(), err)
 	}
 	return nil
 }
 
 func waitForLoadBalancerActive(ctx context.Context, d *schema.ResourceData, config *ProviderConfig, id string) error {
-	stateConf := &resource.StateChangeConf{
+	waiter := &Waiter[vloadbalancer.LoadBalancerInstance]{
+		Name:    fmt.Sprintf("Load Balancer instance (%s)", id),
 		Pending: []string{LoadBalancerInstanceOperationCreateCode, LoadBalancerInstanceOperationChangeCode},
 		Target:  []string{LoadBalancerInstanceOperationNullCode},
-		Refresh: func() (result interface{}, state string, err error) {
-			reqParams := &vloadbalancer.GetLoadBalancerInstanceDetailRequest{
-				RegionCode:             &config.RegionCode,
-				LoadBalancerInstanceNo: ncloud.String(id),
-			}
-			resp, err := config.Client.vloadbalancer.V2Api.GetLoadBalancerInstanceDetail(reqParams)
-			if err != nil {
-				return nil, "", err
-			}
-
-			if len(resp.LoadBalancerInstanceList) < 1 {
-				return nil, "", fmt.Errorf("not found load balancer instance(%s)", id)
+		Refresh: func() (*vloadbalancer.LoadBalancerInstance, error) {
+			lb, err := getVpcLoadBalancerInstance(config, id)
+			if err == nil && lb == nil {
+				return nil, fmt.Errorf("not found load balancer instance(%s)", id)
 			}
-
-			lb := resp.LoadBalancerInstanceList[0]
-			return resp, ncloud.StringValue(lb.LoadBalancerInstanceOperation.Code), nil
+			return lb, err
+		},
+		Status: func(lb *vloadbalancer.LoadBalancerInstance) string {
+			return commonCodeValue(lb.LoadBalancerInstanceOperation)
 		},
-		Timeout:    d.Timeout(schema.TimeoutCreate),
-		MinTimeout: 3 * time.Second,
-		Delay:      2 * time.Second,
+		Timeout: d.Timeout(schema.TimeoutCreate),
+		Delay:   2 * time.Second,
 	}
-	if _, err := stateConf.WaitForStateContext(ctx); err != nil {
+	if _, err := waiter.Wait(ctx); err != nil {
 		return fmt.Errorf("error waiting for Load Balancer instance (%s) to become activating: %s", id, err)
 	}
 	return nil
 }
 
-func getVpcLoadBalancer(config *ProviderConfig, id string) (*LoadBalancerInstance, error) {
+func getVpcLoadBalancerInstance(config *ProviderConfig, id string) (*vloadbalancer.LoadBalancerInstance, error) {
 	reqParams := &vloadbalancer.GetLoadBalancerInstanceDetailRequest{
 		RegionCode:             &config.RegionCode,
 		LoadBalancerInstanceNo: ncloud.String(id),
@@ -324,7 +306,16 @@ func getVpcLoadBalancer(config *ProviderConfig, id string) (*LoadBalancerInstanc
 		return nil, nil
 	}
 
-	return convertVpcLoadBalancer(resp.LoadBalancerInstanceList[0]), nil
+	return resp.LoadBalancerInstanceList[0], nil
+}
+
+func getVpcLoadBalancer(config *ProviderConfig, id string) (*LoadBalancerInstance, error) {
+	instance, err := getVpcLoadBalancerInstance(config, id)
+	if err != nil || instance == nil {
+		return nil, err
+	}
+
+	return convertVpcLoadBalancer(instance), nil
 }
 
 func convertVpcLoadBalancer(instance *vloadbalancer.LoadBalancerInstance) *LoadBalancerInstance {
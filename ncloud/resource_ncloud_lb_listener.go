@@ -105,8 +105,7 @@ func resourceNcloudLbListenerCreate(ctx context.Context, d *schema.ResourceData,
 	err := resource.RetryContext(ctx, d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
 		resp, err := config.Client.vloadbalancer.V2Api.CreateLoadBalancerListener(reqParams)
 		if err != nil {
-			errBody, _ := GetCommonErrorBody(err)
-			if errBody.ReturnCode == LoadBalancerListenerBusyStateErrorCode || errBody.ReturnCode == LoadBalancerListenerServerErrorCode {
+			if apiErrorCode(err) == LoadBalancerListenerBusyStateErrorCode || apiErrorCode(err) == LoadBalancerListenerServerErrorCode {
 				return resource.RetryableError(err)
 			}
 			return resource.NonRetryableError(err)
@@ -116,7 +115,7 @@ func resourceNcloudLbListenerCreate(ctx context.Context, d *schema.ResourceData,
 	})
 
 	if err != nil {
-		return diag.FromErr(err)
+		return ToDiagnostics(err)
 	}
 
 	d.SetId(ncloud.StringValue(listener.LoadBalancerListenerNo))
@@ -131,7 +130,7 @@ func resourceNcloudLbListenerRead(ctx context.Context, d *schema.ResourceData, m
 
 	listener, err := getVpcLoadBalancerListener(config, d.Id(), d.Get("load_balancer_no").(string))
 	if err != nil {
-		return diag.FromErr(err)
+		return ToDiagnostics(err)
 	}
 
 	if listener == nil {
@@ -167,8 +166,7 @@ func resourceNcloudLbListenerUpdate(ctx context.Context, d *schema.ResourceData,
 		err := resource.RetryContext(ctx, d.Timeout(schema.TimeoutUpdate), func() *resource.RetryError {
 			_, err := config.Client.vloadbalancer.V2Api.ChangeLoadBalancerListenerConfiguration(reqParams)
 			if err != nil {
-				errBody, _ := GetCommonErrorBody(err)
-				if errBody.ReturnCode == LoadBalancerListenerBusyStateErrorCode || errBody.ReturnCode == LoadBalancerListenerServerErrorCode {
+				if apiErrorCode(err) == LoadBalancerListenerBusyStateErrorCode || apiErrorCode(err) == LoadBalancerListenerServerErrorCode {
 					return resource.RetryableError(err)
 				}
 				return resource.NonRetryableError(err)
@@ -177,7 +175,7 @@ func resourceNcloudLbListenerUpdate(ctx context.Context, d *schema.ResourceData,
 		})
 
 		if err != nil {
-			return diag.FromErr(err)
+			return ToDiagnostics(err)
 		}
 	}
 
@@ -197,8 +195,7 @@ func resourceNcloudLbListenerDelete(ctx context.Context, d *schema.ResourceData,
 	err := resource.RetryContext(ctx, d.Timeout(schema.TimeoutDelete), func() *resource.RetryError {
 		_, err := config.Client.vloadbalancer.V2Api.DeleteLoadBalancerListeners(reqParams)
 		if err != nil {
-			errBody, _ := GetCommonErrorBody(err)
-			if errBody.ReturnCode == LoadBalancerListenerBusyStateErrorCode || errBody.ReturnCode == LoadBalancerListenerServerErrorCode {
+			if apiErrorCode(err) == LoadBalancerListenerBusyStateErrorCode || apiErrorCode(err) == LoadBalancerListenerServerErrorCode {
 				return resource.RetryableError(err)
 			}
 			return resource.NonRetryableError(err)
@@ -207,7 +204,7 @@ func resourceNcloudLbListenerDelete(ctx context.Context, d *schema.ResourceData,
 	})
 
 	if err != nil {
-		return diag.FromErr(err)
+		return ToDiagnostics(err)
 	}
 	return nil
 }
@@ -161,11 +161,11 @@ func resourceNcloudTargetGroupCreate(ctx context.Context, d *schema.ResourceData
 	}
 
 	if err := validateVpcTargetGroupVpc(config, *reqParams.VpcNo); err != nil {
-		return diag.FromErr(err)
+		return ToDiagnostics(err)
 	}
 
 	if err := validateVpcTargetGroupDuplicateName(config, ncloud.StringValue(reqParams.TargetGroupName)); err != nil {
-		return diag.FromErr(err)
+		return ToDiagnostics(err)
 	}
 
 	if healthChecks, ok := d.GetOk("health_check"); ok {
@@ -179,7 +179,7 @@ func resourceNcloudTargetGroupCreate(ctx context.Context, d *schema.ResourceData
 		// Required
 		reqParams.HealthCheckProtocolTypeCode = ncloud.String(healthCheck["protocol"].(string))
 		if err := validateHealthCheckProtocolByTargetGroupProtocol(*reqParams.TargetGroupProtocolTypeCode, *reqParams.HealthCheckProtocolTypeCode); err != nil {
-			return diag.FromErr(err)
+			return ToDiagnostics(err)
 		}
 
 		if *reqParams.HealthCheckProtocolTypeCode == "HTTP" || *reqParams.HealthCheckProtocolTypeCode == "HTTPS" {
@@ -196,7 +196,7 @@ func resourceNcloudTargetGroupCreate(ctx context.Context, d *schema.ResourceData
 	logResponse("resourceNcloudTargetGroupCreate", resp)
 	if err != nil {
 		logErrorResponse("resourceNcloudTargetGroupCreate", err, reqParams)
-		return diag.FromErr(err)
+		return ToDiagnostics(err)
 	}
 
 	d.SetId(ncloud.StringValue(resp.TargetGroupList[0].TargetGroupNo))
@@ -211,7 +211,7 @@ func resourceNcloudTargetGroupRead(ctx context.Context, d *schema.ResourceData,
 
 	tg, err := getVpcLoadBalancerTargetGroup(config, d.Id())
 	if err != nil {
-		return diag.FromErr(err)
+		return ToDiagnostics(err)
 	}
 
 	if tg == nil {
@@ -255,7 +255,7 @@ func resourceNcloudTargetGroupUpdate(ctx context.Context, d *schema.ResourceData
 		logCommonRequest("resourceNcloudTargetGroupUpdate", reqParams)
 		if _, err := config.Client.vloadbalancer.V2Api.ChangeTargetGroupHealthCheckConfiguration(reqParams); err != nil {
 			logErrorResponse("resourceNcloudTargetGroupUpdate", err, reqParams)
-			return diag.FromErr(err)
+			return ToDiagnostics(err)
 		}
 	}
 
@@ -275,11 +275,11 @@ func resourceNcloudTargetGroupUpdate(ctx context.Context, d *schema.ResourceData
 		}
 
 		if err := validateAlgorithmTypeByTargetGroupProtocol(*reqParams.AlgorithmTypeCode, targetGroupProtocol); err != nil {
-			return diag.FromErr(err)
+			return ToDiagnostics(err)
 		}
 		logCommonRequest("resourceNcloudTargetGroupUpdate", reqParams)
 		if _, err := config.Client.vloadbalancer.V2Api.ChangeTargetGroupConfiguration(reqParams); err != nil {
-			return diag.FromErr(err)
+			return ToDiagnostics(err)
 		}
 	}
 
@@ -296,7 +296,7 @@ func resourceNcloudTargetGroupDelete(ctx context.Context, d *schema.ResourceData
 		TargetGroupNoList: []*string{ncloud.String(d.Id())},
 	}
 	if _, err := config.Client.vloadbalancer.V2Api.DeleteTargetGroups(reqParams); err != nil {
-		return diag.FromErr(err)
+		return ToDiagnostics(err)
 	}
 
 	return nil
@@ -9,7 +9,6 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"log"
-	"time"
 )
 
 const (
@@ -23,15 +22,19 @@ func init() {
 }
 
 func resourceNcloudLbTargetGroupAttachment() *schema.Resource {
-	return &schema.Resource{
+	r := &schema.Resource{
 		CreateContext: resourceNcloudLbTargetGroupAttachmentCreate,
 		ReadContext:   resourceNcloudLbTargetGroupAttachmentRead,
 		UpdateContext: resourceNcloudLbTargetGroupAttachmentUpdate,
 		DeleteContext: resourceNcloudLbTargetGroupAttachmentDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceNcloudLbTargetGroupAttachmentImportState,
+		},
 		Timeouts: &schema.ResourceTimeout{
 			Create: schema.DefaultTimeout(DefaultCreateTimeout),
 			Delete: schema.DefaultTimeout(DefaultTimeout),
 		},
+		SchemaVersion: 1,
 		Schema: map[string]*schema.Schema{
 			"target_group_no": {
 				Type:     schema.TypeString,
@@ -46,6 +49,16 @@ func resourceNcloudLbTargetGroupAttachment() *schema.Resource {
 			},
 		},
 	}
+	r.StateUpgraders = []schema.StateUpgrader{
+		resourceNcloudLbTargetGroupAttachmentStateUpgradeV0(r),
+	}
+
+	return r
+}
+
+// resourceNcloudLbTargetGroupAttachmentStateUpgradeV0 replaces the timestamp ID of the attachment with `target_group_no`
+func resourceNcloudLbTargetGroupAttachmentStateUpgradeV0(r *schema.Resource) schema.StateUpgrader {
+	return stateUpgrader(0, r, setStateId("target_group_no"))
 }
 
 func resourceNcloudLbTargetGroupAttachmentCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
@@ -62,10 +75,10 @@ func resourceNcloudLbTargetGroupAttachmentCreate(ctx context.Context, d *schema.
 	err := waitForAddTarget(ctx, d, config, reqParams)
 
 	if err != nil {
-		return diag.FromErr(err)
+		return ToDiagnostics(err)
 	}
 
-	d.SetId(time.Now().UTC().String())
+	d.SetId(d.Get("target_group_no").(string))
 	return nil
 }
 
@@ -77,13 +90,12 @@ func resourceNcloudLbTargetGroupAttachmentRead(ctx context.Context, d *schema.Re
 
 	targetNoList, err := getVpcLoadBalancerTargetGroupAttachment(config, d.Get("target_group_no").(string), ncloud.StringListValue(ncloud.StringInterfaceList(d.Get("target_no_list").([]interface{}))))
 	if err != nil {
-		errorBody, _ := GetCommonErrorBody(err)
-		if errorBody.ReturnCode == TargetGroupAttachmentInvalidTargetGroupNoErrorCode {
+		if apiErrorCode(err) == TargetGroupAttachmentInvalidTargetGroupNoErrorCode {
 			log.Printf("[WARN] Target group does not exist, removing target attachment %s", d.Id())
 			d.SetId("")
 			return nil
 		}
-		return diag.FromErr(err)
+		return ToDiagnostics(err)
 	}
 
 	if targetNoList == nil {
@@ -176,21 +188,60 @@ func resourceNcloudLbTargetGroupAttachmentDelete(ctx context.Context, d *schema.
 	err := waitForRemoveTarget(ctx, d, config, reqParams)
 
 	if err != nil {
-		return diag.FromErr(err)
+		return ToDiagnostics(err)
 	}
 	return nil
 }
 
-func getVpcLoadBalancerTargetGroupAttachment(config *ProviderConfig, targetGroupNo string, targetNoList []string) ([]string, error) {
+// resourceNcloudLbTargetGroupAttachmentImportState imports all the targets of the target group by the target group number
+func resourceNcloudLbTargetGroupAttachmentImportState(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	config := meta.(*ProviderConfig)
+	if !config.SupportVPC {
+		return nil, NotSupportClassic("resource `ncloud_lb_target_group_attachment`")
+	}
+
+	targetGroupNo := d.Id()
+	targetList, err := getVpcLoadBalancerTargetList(config, targetGroupNo)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(targetList) < 1 {
+		return nil, fmt.Errorf("no targets are attached to the target group (%s)", targetGroupNo)
+	}
+
+	targetNoList := make([]string, 0, len(targetList))
+	for _, target := range targetList {
+		targetNoList = append(targetNoList, ncloud.StringValue(target.TargetNo))
+	}
+
+	d.Set("target_group_no", targetGroupNo)
+	d.Set("target_no_list", targetNoList)
+	return []*schema.ResourceData{d}, nil
+}
+
+func getVpcLoadBalancerTargetList(config *ProviderConfig, targetGroupNo string) ([]*vloadbalancer.Target, error) {
 	reqParams := &vloadbalancer.GetTargetListRequest{
 		RegionCode:    &config.RegionCode,
 		TargetGroupNo: ncloud.String(targetGroupNo),
 	}
+	logCommonRequest("getVpcLoadBalancerTargetList", reqParams)
 	resp, err := config.Client.vloadbalancer.V2Api.GetTargetList(reqParams)
+	if err != nil {
+		logErrorResponse("getVpcLoadBalancerTargetList", err, reqParams)
+		return nil, err
+	}
+	logResponse("getVpcLoadBalancerTargetList", resp)
+
+	return resp.TargetList, nil
+}
+
+func getVpcLoadBalancerTargetGroupAttachment(config *ProviderConfig, targetGroupNo string, targetNoList []string) ([]string, error) {
+	targetList, err := getVpcLoadBalancerTargetList(config, targetGroupNo)
 	if err != nil {
 		return nil, err
 	}
-	matchTargetNoList := getMatchTargetNoListFromResponse(resp.TargetList, targetNoList)
+	matchTargetNoList := getMatchTargetNoListFromResponse(targetList, targetNoList)
 
 	if len(matchTargetNoList) < 1 {
 		return nil, nil
@@ -221,8 +272,7 @@ func waitForAddTarget(ctx context.Context, d *schema.ResourceData, config *Provi
 		logCommonRequest("resourceNcloudLbTargetGroupAttachmentCreate", reqParams)
 		resp, err := config.Client.vloadbalancer.V2Api.AddTarget(reqParams)
 		if err != nil {
-			errBody, _ := GetCommonErrorBody(err)
-			if errBody.ReturnCode == TargetGroupAttachmentBusyStateErrorCode || errBody.ReturnCode == TargetGroupAttachmentPleaseTryAgainErrorCode {
+			if apiErrorCode(err) == TargetGroupAttachmentBusyStateErrorCode || apiErrorCode(err) == TargetGroupAttachmentPleaseTryAgainErrorCode {
 				return resource.RetryableError(err)
 			}
 			logErrorResponse("resourceNcloudLbTargetGroupAttachmentCreate", err, reqParams)
@@ -239,8 +289,7 @@ func waitForRemoveTarget(ctx context.Context, d *schema.ResourceData, config *Pr
 		logCommonRequest("resourceNcloudLbTargetGroupAttachmentDelete", reqParams)
 		resp, err := config.Client.vloadbalancer.V2Api.RemoveTarget(reqParams)
 		if err != nil {
-			errBody, _ := GetCommonErrorBody(err)
-			if errBody.ReturnCode == TargetGroupAttachmentBusyStateErrorCode || errBody.ReturnCode == TargetGroupAttachmentPleaseTryAgainErrorCode {
+			if apiErrorCode(err) == TargetGroupAttachmentBusyStateErrorCode || apiErrorCode(err) == TargetGroupAttachmentPleaseTryAgainErrorCode {
 				return resource.RetryableError(err)
 			}
 			logErrorResponse("resourceNcloudLbTargetGroupAttachmentDelete", err, reqParams)
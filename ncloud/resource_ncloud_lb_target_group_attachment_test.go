@@ -30,10 +30,27 @@ func TestAccResourceNcloudLbTargetGroupAttachment_basic(t *testing.T) {
 					resource.TestCheckResourceAttr(resourceName, "target_no_list.#", "1"),
 				),
 			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateIdFunc: testAccNcloudLbTargetGroupAttachmentImportStateIDFunc(resourceName),
+				ImportStateVerify: true,
+			},
 		},
 	})
 }
 
+func testAccNcloudLbTargetGroupAttachmentImportStateIDFunc(resourceName string) resource.ImportStateIdFunc {
+	return func(s *terraform.State) (string, error) {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return "", fmt.Errorf("not found: %s", resourceName)
+		}
+
+		return rs.Primary.Attributes["target_group_no"], nil
+	}
+}
+
 func testAccCheckLbTargetGroupAttachmentExists(n string, t *string, provider *schema.Provider) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		rs, ok := s.RootModule().Resources[n]
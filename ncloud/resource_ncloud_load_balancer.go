@@ -1,12 +1,14 @@
 package ncloud
 
 import (
+	"context"
 	"fmt"
 	"log"
 	"time"
 
 	"github.com/NaverCloudPlatform/ncloud-sdk-go-v2/ncloud"
 	"github.com/NaverCloudPlatform/ncloud-sdk-go-v2/services/loadbalancer"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
@@ -17,19 +19,20 @@ func init() {
 }
 
 func resourceNcloudLoadBalancer() *schema.Resource {
-	return &schema.Resource{
-		Create: resourceNcloudLoadBalancerCreate,
-		Read:   resourceNcloudLoadBalancerRead,
-		Update: resourceNcloudLoadBalancerUpdate,
-		Delete: resourceNcloudLoadBalancerDelete,
+	r := &schema.Resource{
+		CreateContext: resourceNcloudLoadBalancerCreate,
+		ReadContext:   resourceNcloudLoadBalancerRead,
+		UpdateContext: resourceNcloudLoadBalancerUpdate,
+		DeleteContext: resourceNcloudLoadBalancerDelete,
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
 		},
 		Timeouts: &schema.ResourceTimeout{
 			Create: schema.DefaultTimeout(DefaultCreateTimeout),
 			Update: schema.DefaultTimeout(DefaultUpdateTimeout),
 			Delete: schema.DefaultTimeout(DefaultTimeout),
 		},
+		SchemaVersion: 1,
 		Schema: map[string]*schema.Schema{
 			"name": {
 				Type:             schema.TypeString,
@@ -69,6 +72,7 @@ func resourceNcloudLoadBalancer() *schema.Resource {
 				Computed:         true,
 				ValidateDiagFunc: ToDiagFunc(validation.StringInSlice([]string{"PUBLC", "GLBL"}, false)),
 				Description:      "Internet line identification code. PUBLC(Public), GLBL(Global). default : PUBLC(Public)",
+				DiffSuppressFunc: suppressDeprecatedDiff,
 				Deprecated:       "This parameter is no longer used.",
 			},
 			"network_usage_type": {
@@ -126,25 +130,35 @@ func resourceNcloudLoadBalancer() *schema.Resource {
 			},
 		},
 	}
+	r.StateUpgraders = []schema.StateUpgrader{
+		resourceNcloudLoadBalancerStateUpgradeV0(r),
+	}
+
+	return r
 }
 
-func resourceNcloudLoadBalancerCreate(d *schema.ResourceData, meta interface{}) error {
+// resourceNcloudLoadBalancerStateUpgradeV0 clears the deprecated `internet_line_type`, which the API no longer uses for the load balancer
+func resourceNcloudLoadBalancerStateUpgradeV0(r *schema.Resource) schema.StateUpgrader {
+	return stateUpgrader(0, r, clearStateAttributes("internet_line_type"))
+}
+
+func resourceNcloudLoadBalancerCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	client := meta.(*ProviderConfig).Client
 	config := meta.(*ProviderConfig)
 
 	if config.SupportVPC {
-		return NotSupportVpc("resource `ncloud_load_balancer`")
+		return diag.FromErr(NotSupportVpc("resource `ncloud_load_balancer`"))
 	}
 
-	reqParams, err := buildCreateLoadBalancerInstanceParams(d)
+	reqParams, err := buildCreateLoadBalancerInstanceParams(config, d)
 	if err != nil {
-		return err
+		return ToDiagnostics(err)
 	}
 	logCommonRequest("CreateLoadBalancerInstance", reqParams)
 	resp, err := client.loadbalancer.V2Api.CreateLoadBalancerInstance(reqParams)
 	if err != nil {
 		logErrorResponse("CreateLoadBalancerInstance", err, reqParams)
-		return err
+		return ToDiagnostics(err)
 	}
 	logCommonResponse("CreateLoadBalancerInstance", GetCommonResponse(resp))
 
@@ -171,20 +185,20 @@ func resourceNcloudLoadBalancerCreate(d *schema.ResourceData, meta interface{})
 		MinTimeout: 3 * time.Second,
 	}
 
-	_, err = stateConf.WaitForState()
+	_, err = stateConf.WaitForStateContext(ctx)
 	if err != nil {
-		return fmt.Errorf("Error waiting for LoadBalancerInstanceStatus state to be \"USED\": %s", err)
+		return diag.FromErr(fmt.Errorf("Error waiting for LoadBalancerInstanceStatus state to be \"USED\": %s", err))
 	}
 
-	return resourceNcloudLoadBalancerRead(d, meta)
+	return resourceNcloudLoadBalancerRead(ctx, d, meta)
 }
 
-func resourceNcloudLoadBalancerRead(d *schema.ResourceData, meta interface{}) error {
+func resourceNcloudLoadBalancerRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	client := meta.(*ProviderConfig).Client
 
 	lb, err := getLoadBalancerInstance(client, d.Id())
 	if err != nil {
-		return err
+		return ToDiagnostics(err)
 	}
 
 	if lb != nil {
@@ -215,13 +229,13 @@ func resourceNcloudLoadBalancerRead(d *schema.ResourceData, meta interface{}) er
 
 		if len(lb.LoadBalancerRuleList) != 0 {
 			if err := d.Set("rule_list", flattenLoadBalancerRuleList(lb.LoadBalancerRuleList)); err != nil {
-				return err
+				return ToDiagnostics(err)
 			}
 		}
 
 		if len(lb.LoadBalancedServerInstanceList) != 0 {
 			if err := d.Set("load_balanced_server_instance_list", flattenLoadBalancedServerInstanceList(lb.LoadBalancedServerInstanceList)); err != nil {
-				return err
+				return ToDiagnostics(err)
 			}
 		} else {
 			d.Set("load_balanced_server_instance_list", nil)
@@ -234,22 +248,22 @@ func resourceNcloudLoadBalancerRead(d *schema.ResourceData, meta interface{}) er
 	return nil
 }
 
-func resourceNcloudLoadBalancerDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceNcloudLoadBalancerDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	client := meta.(*ProviderConfig).Client
-	if err := deleteLoadBalancerInstance(client, d.Id()); err != nil {
-		return err
+	if err := deleteLoadBalancerInstance(ctx, client, d.Id()); err != nil {
+		return ToDiagnostics(err)
 	}
 	d.SetId("")
 	return nil
 }
 
-func resourceNcloudLoadBalancerUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceNcloudLoadBalancerUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	client := meta.(*ProviderConfig).Client
 
 	// Change Load Balanced Server Instances
 	if d.HasChange("server_instance_no_list") {
-		if err := changeLoadBalancedServerInstances(client, d); err != nil {
-			return err
+		if err := changeLoadBalancedServerInstances(ctx, client, d); err != nil {
+			return ToDiagnostics(err)
 		}
 	}
 
@@ -271,7 +285,7 @@ func resourceNcloudLoadBalancerUpdate(d *schema.ResourceData, meta interface{})
 		resp, err := client.loadbalancer.V2Api.ChangeLoadBalancerInstanceConfiguration(reqParams)
 		if err != nil {
 			logErrorResponse("ChangeLoadBalancerInstanceConfiguration", err, reqParams)
-			return err
+			return ToDiagnostics(err)
 		}
 		logCommonResponse("ChangeLoadBalancerInstanceConfiguration", GetCommonResponse(resp))
 
@@ -295,16 +309,16 @@ func resourceNcloudLoadBalancerUpdate(d *schema.ResourceData, meta interface{})
 			MinTimeout: 3 * time.Second,
 		}
 
-		_, err = stateConf.WaitForState()
+		_, err = stateConf.WaitForStateContext(ctx)
 		if err != nil {
-			return fmt.Errorf("Error waiting for LoadBalancerInstanceStatus state to be \"USED\": %s", err)
+			return diag.FromErr(fmt.Errorf("Error waiting for LoadBalancerInstanceStatus state to be \"USED\": %s", err))
 		}
 	}
 
-	return resourceNcloudLoadBalancerRead(d, meta)
+	return resourceNcloudLoadBalancerRead(ctx, d, meta)
 }
 
-func changeLoadBalancedServerInstances(client *NcloudAPIClient, d *schema.ResourceData) error {
+func changeLoadBalancedServerInstances(ctx context.Context, client *NcloudAPIClient, d *schema.ResourceData) error {
 	reqParams := &loadbalancer.ChangeLoadBalancedServerInstancesRequest{
 		LoadBalancerInstanceNo: ncloud.String(d.Id()),
 		ServerInstanceNoList:   expandStringInterfaceList(d.Get("server_instance_no_list").([]interface{})),
@@ -339,7 +353,7 @@ func changeLoadBalancedServerInstances(client *NcloudAPIClient, d *schema.Resour
 		MinTimeout: 3 * time.Second,
 	}
 
-	_, err = stateConf.WaitForState()
+	_, err = stateConf.WaitForStateContext(ctx)
 	if err != nil {
 		return fmt.Errorf("Error waiting for LoadBalancerInstanceStatus state to be \"USED\": %s", err)
 	}
@@ -347,8 +361,8 @@ func changeLoadBalancedServerInstances(client *NcloudAPIClient, d *schema.Resour
 	return nil
 }
 
-func buildCreateLoadBalancerInstanceParams(d *schema.ResourceData) (*loadbalancer.CreateLoadBalancerInstanceRequest, error) {
-	regionNo, err := parseRegionNoParameter(d)
+func buildCreateLoadBalancerInstanceParams(config *ProviderConfig, d *schema.ResourceData) (*loadbalancer.CreateLoadBalancerInstanceRequest, error) {
+	regionNo, err := parseRegionNoParameter(config, d)
 	if err != nil {
 		return nil, err
 	}
@@ -404,7 +418,7 @@ func getLoadBalancerInstance(client *NcloudAPIClient, loadBalancerInstanceNo str
 	return nil, nil
 }
 
-func deleteLoadBalancerInstance(client *NcloudAPIClient, loadBalancerInstanceNo string) error {
+func deleteLoadBalancerInstance(ctx context.Context, client *NcloudAPIClient, loadBalancerInstanceNo string) error {
 	reqParams := &loadbalancer.DeleteLoadBalancerInstancesRequest{
 		LoadBalancerInstanceNoList: []*string{ncloud.String(loadBalancerInstanceNo)},
 	}
@@ -440,7 +454,7 @@ func deleteLoadBalancerInstance(client *NcloudAPIClient, loadBalancerInstanceNo
 		MinTimeout: 3 * time.Second,
 	}
 
-	_, err = stateConf.WaitForState()
+	_, err = stateConf.WaitForStateContext(ctx)
 	if err != nil {
 		return fmt.Errorf("Error waiting to delete LoadBalancerInstance: %s", err)
 	}
@@ -1,10 +1,12 @@
 package ncloud
 
 import (
+	"context"
 	"log"
 
 	"github.com/NaverCloudPlatform/ncloud-sdk-go-v2/ncloud"
 	"github.com/NaverCloudPlatform/ncloud-sdk-go-v2/services/loadbalancer"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
@@ -14,12 +16,12 @@ func init() {
 
 func resourceNcloudLoadBalancerSSLCertificate() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceNcloudLoadBalancerSSLCertificateCreate,
-		Read:   resourceNcloudLoadBalancerSSLCertificateRead,
-		Update: resourceNcloudLoadBalancerSSLCertificateUpdate,
-		Delete: resourceNcloudLoadBalancerSSLCertificateDelete,
+		CreateContext: resourceNcloudLoadBalancerSSLCertificateCreate,
+		ReadContext:   resourceNcloudLoadBalancerSSLCertificateRead,
+		UpdateContext: resourceNcloudLoadBalancerSSLCertificateUpdate,
+		DeleteContext: resourceNcloudLoadBalancerSSLCertificateDelete,
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
 		},
 		Timeouts: &schema.ResourceTimeout{
 			Create: schema.DefaultTimeout(DefaultCreateTimeout),
@@ -34,6 +36,7 @@ func resourceNcloudLoadBalancerSSLCertificate() *schema.Resource {
 			"privatekey": {
 				Type:        schema.TypeString,
 				Required:    true,
+				Sensitive:   true,
 				Description: "Private key for a certificate",
 			},
 			"publickey_certificate": {
@@ -50,18 +53,18 @@ func resourceNcloudLoadBalancerSSLCertificate() *schema.Resource {
 	}
 }
 
-func resourceNcloudLoadBalancerSSLCertificateCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceNcloudLoadBalancerSSLCertificateCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	client := meta.(*ProviderConfig).Client
 	config := meta.(*ProviderConfig)
 
 	if config.SupportVPC {
-		return NotSupportVpc("resource `ncloud_load_balancer_ssl_certificate`")
+		return diag.FromErr(NotSupportVpc("resource `ncloud_load_balancer_ssl_certificate`"))
 	}
 
 	reqParams, err := buildCreateLoadBalancerSSLCertificateParams(d)
 	if err != nil {
 		logErrorResponse("AddLoadBalancerSslCertificate", err, reqParams)
-		return err
+		return ToDiagnostics(err)
 	}
 
 	logCommonRequest("AddLoadBalancerSslCertificate", reqParams)
@@ -69,7 +72,7 @@ func resourceNcloudLoadBalancerSSLCertificateCreate(d *schema.ResourceData, meta
 	resp, err := client.loadbalancer.V2Api.AddLoadBalancerSslCertificate(reqParams)
 	if err != nil {
 		logErrorResponse("AddLoadBalancerSslCertificate", err, reqParams)
-		return err
+		return ToDiagnostics(err)
 	}
 
 	logCommonResponse("AddLoadBalancerSslCertificate", GetCommonResponse(resp))
@@ -77,15 +80,15 @@ func resourceNcloudLoadBalancerSSLCertificateCreate(d *schema.ResourceData, meta
 	cert := resp.SslCertificateList[0]
 	d.SetId(*cert.CertificateName)
 
-	return resourceNcloudLoadBalancerSSLCertificateRead(d, meta)
+	return resourceNcloudLoadBalancerSSLCertificateRead(ctx, d, meta)
 }
 
-func resourceNcloudLoadBalancerSSLCertificateRead(d *schema.ResourceData, meta interface{}) error {
+func resourceNcloudLoadBalancerSSLCertificateRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	client := meta.(*ProviderConfig).Client
 
 	lb, err := getLoadBalancerSslCertificateList(client, d.Id())
 	if err != nil {
-		return err
+		return ToDiagnostics(err)
 	}
 	if lb != nil {
 		d.Set("certificate_name", lb.CertificateName)
@@ -100,17 +103,17 @@ func resourceNcloudLoadBalancerSSLCertificateRead(d *schema.ResourceData, meta i
 	return nil
 }
 
-func resourceNcloudLoadBalancerSSLCertificateDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceNcloudLoadBalancerSSLCertificateDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	client := meta.(*ProviderConfig).Client
 	if err := deleteLoadBalancerSSLCertificate(client, d.Id()); err != nil {
-		return err
+		return ToDiagnostics(err)
 	}
 	d.SetId("")
 	return nil
 }
 
-func resourceNcloudLoadBalancerSSLCertificateUpdate(d *schema.ResourceData, meta interface{}) error {
-	return resourceNcloudLoadBalancerSSLCertificateRead(d, meta)
+func resourceNcloudLoadBalancerSSLCertificateUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	return resourceNcloudLoadBalancerSSLCertificateRead(ctx, d, meta)
 }
 
 func buildCreateLoadBalancerSSLCertificateParams(d *schema.ResourceData) (*loadbalancer.AddLoadBalancerSslCertificateRequest, error) {
@@ -1,6 +1,7 @@
 package ncloud
 
 import (
+	"context"
 	"fmt"
 	"github.com/NaverCloudPlatform/ncloud-sdk-go-v2/ncloud"
 	"strings"
@@ -8,6 +9,7 @@ import (
 
 	"github.com/NaverCloudPlatform/ncloud-sdk-go-v2/services/server"
 	"github.com/NaverCloudPlatform/ncloud-sdk-go-v2/services/vserver"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
@@ -19,11 +21,11 @@ func init() {
 
 func resourceNcloudLoginKey() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceNcloudLoginKeyCreate,
-		Read:   resourceNcloudLoginKeyRead,
-		Delete: resourceNcloudLoginKeyDelete,
+		CreateContext: resourceNcloudLoginKeyCreate,
+		ReadContext:   resourceNcloudLoginKeyRead,
+		DeleteContext: resourceNcloudLoginKeyDelete,
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
 		},
 		Timeouts: &schema.ResourceTimeout{
 			Create: schema.DefaultTimeout(DefaultTimeout),
@@ -50,10 +52,10 @@ func resourceNcloudLoginKey() *schema.Resource {
 	}
 }
 
-func resourceNcloudLoginKeyRead(d *schema.ResourceData, meta interface{}) error {
+func resourceNcloudLoginKeyRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	loginKey, err := getLoginKey(meta.(*ProviderConfig), d.Id())
 	if err != nil {
-		return err
+		return ToDiagnostics(err)
 	}
 
 	if loginKey == nil {
@@ -66,7 +68,7 @@ func resourceNcloudLoginKeyRead(d *schema.ResourceData, meta interface{}) error
 	return nil
 }
 
-func resourceNcloudLoginKeyCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceNcloudLoginKeyCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	var privateKey *string
 	var err error
 
@@ -79,29 +81,32 @@ func resourceNcloudLoginKeyCreate(d *schema.ResourceData, meta interface{}) erro
 	}
 
 	if err != nil {
-		return err
+		return ToDiagnostics(err)
 	}
 
 	d.SetId(keyName)
 	d.Set("private_key", strings.TrimSpace(*privateKey))
 
-	time.Sleep(time.Second * 1) // for internal Master / Slave DB sync
+	// for internal Master / Slave DB sync
+	if err := sleepContext(ctx, time.Second*1); err != nil {
+		return ToDiagnostics(err)
+	}
 
-	return resourceNcloudLoginKeyRead(d, meta)
+	return resourceNcloudLoginKeyRead(ctx, d, meta)
 }
 
-func resourceNcloudLoginKeyDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceNcloudLoginKeyDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	config := meta.(*ProviderConfig)
 
 	keyName := d.Id()
 
 	if config.SupportVPC == true {
-		if err := deleteVpcLoginKey(config, keyName); err != nil {
-			return err
+		if err := deleteVpcLoginKey(ctx, config, keyName); err != nil {
+			return ToDiagnostics(err)
 		}
 	} else {
-		if err := deleteClassicLoginKey(config, keyName); err != nil {
-			return err
+		if err := deleteClassicLoginKey(ctx, config, keyName); err != nil {
+			return ToDiagnostics(err)
 		}
 	}
 
@@ -158,7 +163,7 @@ func getClassicLoginKey(config *ProviderConfig, keyName string) (*LoginKey, erro
 	}, nil
 }
 
-func deleteClassicLoginKey(config *ProviderConfig, keyName string) error {
+func deleteClassicLoginKey(ctx context.Context, config *ProviderConfig, keyName string) error {
 	reqParams := &server.DeleteLoginKeyRequest{KeyName: ncloud.String(keyName)}
 
 	logCommonRequest("deleteClassicLoginKey", reqParams)
@@ -189,7 +194,7 @@ func deleteClassicLoginKey(config *ProviderConfig, keyName string) error {
 		MinTimeout: 3 * time.Second,
 	}
 
-	_, err = stateConf.WaitForState()
+	_, err = stateConf.WaitForStateContext(ctx)
 	if err != nil {
 		return fmt.Errorf("Error waiting to delete LoginKey: %s", err)
 	}
@@ -197,7 +202,7 @@ func deleteClassicLoginKey(config *ProviderConfig, keyName string) error {
 	return nil
 }
 
-func deleteVpcLoginKey(config *ProviderConfig, keyName string) error {
+func deleteVpcLoginKey(ctx context.Context, config *ProviderConfig, keyName string) error {
 	reqParams := &vserver.DeleteLoginKeysRequest{KeyNameList: []*string{ncloud.String(keyName)}}
 
 	logCommonRequest("deleteVpcLoginKey", reqParams)
@@ -228,7 +233,7 @@ func deleteVpcLoginKey(config *ProviderConfig, keyName string) error {
 		MinTimeout: 3 * time.Second,
 	}
 
-	_, err = stateConf.WaitForState()
+	_, err = stateConf.WaitForStateContext(ctx)
 	if err != nil {
 		return fmt.Errorf("Error waiting to delete LoginKey: %s", err)
 	}
@@ -1,6 +1,7 @@
 package ncloud
 
 import (
+	"context"
 	"fmt"
 	"github.com/NaverCloudPlatform/ncloud-sdk-go-v2/services/vnas"
 	"log"
@@ -8,6 +9,7 @@ import (
 
 	"github.com/NaverCloudPlatform/ncloud-sdk-go-v2/ncloud"
 	"github.com/NaverCloudPlatform/ncloud-sdk-go-v2/services/server"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
@@ -19,18 +21,19 @@ func init() {
 
 func resourceNcloudNasVolume() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceNcloudNasVolumeCreate,
-		Read:   resourceNcloudNasVolumeRead,
-		Update: resourceNcloudNasVolumeUpdate,
-		Delete: resourceNcloudNasVolumeDelete,
+		CreateContext: resourceNcloudNasVolumeCreate,
+		ReadContext:   resourceNcloudNasVolumeRead,
+		UpdateContext: resourceNcloudNasVolumeUpdate,
+		DeleteContext: resourceNcloudNasVolumeDelete,
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
 		},
 		Timeouts: &schema.ResourceTimeout{
 			Create: schema.DefaultTimeout(DefaultCreateTimeout),
 			Delete: schema.DefaultTimeout(DefaultTimeout),
 		},
 		Schema: map[string]*schema.Schema{
+			"platform": platformSchema(),
 			"volume_name_postfix": {
 				Type:             schema.TypeString,
 				Required:         true,
@@ -66,9 +69,10 @@ func resourceNcloudNasVolume() *schema.Resource {
 				ForceNew: true,
 			},
 			"cifs_user_password": {
-				Type:     schema.TypeString,
-				Optional: true,
-				ForceNew: true,
+				Type:      schema.TypeString,
+				Optional:  true,
+				ForceNew:  true,
+				Sensitive: true,
 			},
 			"description": {
 				Type:             schema.TypeString,
@@ -120,26 +124,32 @@ func resourceNcloudNasVolume() *schema.Resource {
 	}
 }
 
-func resourceNcloudNasVolumeCreate(d *schema.ResourceData, meta interface{}) error {
-	config := meta.(*ProviderConfig)
+func resourceNcloudNasVolumeCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config, err := resourcePlatformConfig(d, meta)
+	if err != nil {
+		return ToDiagnostics(err)
+	}
 
-	id, err := createNasVolume(d, config)
+	id, err := createNasVolume(ctx, d, config)
 	if err != nil {
-		return err
+		return ToDiagnostics(err)
 	}
 
 	d.SetId(ncloud.StringValue(id))
 	log.Printf("[INFO] NAS Volume ID: %s", d.Id())
 
-	return resourceNcloudNasVolumeRead(d, meta)
+	return resourceNcloudNasVolumeRead(ctx, d, meta)
 }
 
-func resourceNcloudNasVolumeRead(d *schema.ResourceData, meta interface{}) error {
-	config := meta.(*ProviderConfig)
+func resourceNcloudNasVolumeRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config, err := resourcePlatformConfig(d, meta)
+	if err != nil {
+		return ToDiagnostics(err)
+	}
 
 	r, err := getNasVolume(config, d.Id())
 	if err != nil {
-		return err
+		return ToDiagnostics(err)
 	}
 
 	if r == nil {
@@ -154,33 +164,39 @@ func resourceNcloudNasVolumeRead(d *schema.ResourceData, meta interface{}) error
 	return nil
 }
 
-func resourceNcloudNasVolumeDelete(d *schema.ResourceData, meta interface{}) error {
-	config := meta.(*ProviderConfig)
+func resourceNcloudNasVolumeDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config, err := resourcePlatformConfig(d, meta)
+	if err != nil {
+		return ToDiagnostics(err)
+	}
 
-	if err := deleteNasVolume(d, config, d.Id()); err != nil {
-		return err
+	if err := deleteNasVolume(ctx, d, config, d.Id()); err != nil {
+		return ToDiagnostics(err)
 	}
 
 	d.SetId("")
 	return nil
 }
 
-func resourceNcloudNasVolumeUpdate(d *schema.ResourceData, meta interface{}) error {
-	config := meta.(*ProviderConfig)
+func resourceNcloudNasVolumeUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config, err := resourcePlatformConfig(d, meta)
+	if err != nil {
+		return ToDiagnostics(err)
+	}
 
 	if d.HasChange("volume_size") {
 		if err := changeNasVolumeSize(d, config); err != nil {
-			return err
+			return ToDiagnostics(err)
 		}
 	}
 
 	if d.HasChange("server_instance_no_list") || d.HasChange("custom_ip_list") {
 		if err := setNasVolumeAccessControl(d, config); err != nil {
-			return err
+			return ToDiagnostics(err)
 		}
 	}
 
-	return resourceNcloudNasVolumeRead(d, meta)
+	return resourceNcloudNasVolumeRead(ctx, d, meta)
 }
 
 func getNasVolume(config *ProviderConfig, id string) (*NasVolume, error) {
@@ -280,7 +296,7 @@ func convertVpcNasVolume(inst *vnas.NasVolumeInstance) *NasVolume {
 	}
 }
 
-func createNasVolume(d *schema.ResourceData, config *ProviderConfig) (*string, error) {
+func createNasVolume(ctx context.Context, d *schema.ResourceData, config *ProviderConfig) (*string, error) {
 	var id *string
 	var err error
 
@@ -294,7 +310,7 @@ func createNasVolume(d *schema.ResourceData, config *ProviderConfig) (*string, e
 		return nil, err
 	}
 
-	if err := waitForNasVolumeCreation(d, config, *id); err != nil {
+	if err := waitForNasVolumeCreation(ctx, d, config, *id); err != nil {
 		return nil, err
 	}
 
@@ -302,7 +318,7 @@ func createNasVolume(d *schema.ResourceData, config *ProviderConfig) (*string, e
 }
 
 func createClassicNasVolume(d *schema.ResourceData, config *ProviderConfig) (*string, error) {
-	regionNo, err := parseRegionNoParameter(d)
+	regionNo, err := parseRegionNoParameter(config, d)
 	if err != nil {
 		return nil, err
 	}
@@ -371,7 +387,7 @@ func createVpcNasVolume(d *schema.ResourceData, config *ProviderConfig) (*string
 	return resp.NasVolumeInstanceList[0].NasVolumeInstanceNo, nil
 }
 
-func waitForNasVolumeCreation(d *schema.ResourceData, config *ProviderConfig, id string) error {
+func waitForNasVolumeCreation(ctx context.Context, d *schema.ResourceData, config *ProviderConfig, id string) error {
 	stateConf := &resource.StateChangeConf{
 		Pending: []string{"INIT"},
 		Target:  []string{"CREAT"},
@@ -393,7 +409,7 @@ func waitForNasVolumeCreation(d *schema.ResourceData, config *ProviderConfig, id
 		MinTimeout: 3 * time.Second,
 	}
 
-	_, err := stateConf.WaitForState()
+	_, err := stateConf.WaitForStateContext(ctx)
 	if err != nil {
 		return fmt.Errorf("error waiting for NasVolumeInstance state to be \"CREAT\": %s", err)
 	}
@@ -401,7 +417,7 @@ func waitForNasVolumeCreation(d *schema.ResourceData, config *ProviderConfig, id
 	return nil
 }
 
-func deleteNasVolume(d *schema.ResourceData, config *ProviderConfig, id string) error {
+func deleteNasVolume(ctx context.Context, d *schema.ResourceData, config *ProviderConfig, id string) error {
 	var err error
 
 	if config.SupportVPC {
@@ -414,7 +430,7 @@ func deleteNasVolume(d *schema.ResourceData, config *ProviderConfig, id string)
 		return err
 	}
 
-	if err := waitForNasVolumeDeletion(d, config, id); err != nil {
+	if err := waitForNasVolumeDeletion(ctx, d, config, id); err != nil {
 		return err
 	}
 
@@ -452,7 +468,7 @@ func deleteVpcNasVolume(config *ProviderConfig, id string) error {
 	return nil
 }
 
-func waitForNasVolumeDeletion(d *schema.ResourceData, config *ProviderConfig, id string) error {
+func waitForNasVolumeDeletion(ctx context.Context, d *schema.ResourceData, config *ProviderConfig, id string) error {
 	stateConf := &resource.StateChangeConf{
 		Pending: []string{"INIT", "CREAT"},
 		Target:  []string{"TERMT"},
@@ -474,7 +490,7 @@ func waitForNasVolumeDeletion(d *schema.ResourceData, config *ProviderConfig, id
 		MinTimeout: 3 * time.Second,
 	}
 
-	_, err := stateConf.WaitForState()
+	_, err := stateConf.WaitForStateContext(ctx)
 	if err != nil {
 		return fmt.Errorf("error waiting for NasVolumeInstance state to be \"TERMT\": %s", err)
 	}
@@ -1,13 +1,14 @@
 package ncloud
 
 import (
+	"context"
 	"fmt"
 	"log"
 	"time"
 
 	"github.com/NaverCloudPlatform/ncloud-sdk-go-v2/ncloud"
 	"github.com/NaverCloudPlatform/ncloud-sdk-go-v2/services/vpc"
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 )
@@ -18,12 +19,12 @@ func init() {
 
 func resourceNcloudNatGateway() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceNcloudNatGatewayCreate,
-		Read:   resourceNcloudNatGatewayRead,
-		Update: resourceNcloudNatGatewayUpdate,
-		Delete: resourceNcloudNatGatewayDelete,
+		CreateContext: resourceNcloudNatGatewayCreate,
+		ReadContext:   resourceNcloudNatGatewayRead,
+		UpdateContext: resourceNcloudNatGatewayUpdate,
+		DeleteContext: resourceNcloudNatGatewayDelete,
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
 		},
 		Schema: map[string]*schema.Schema{
 			"name": {
@@ -61,11 +62,11 @@ func resourceNcloudNatGateway() *schema.Resource {
 	}
 }
 
-func resourceNcloudNatGatewayCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceNcloudNatGatewayCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	config := meta.(*ProviderConfig)
 
 	if !config.SupportVPC {
-		return NotSupportClassic("resource `ncloud_nat_gateway`")
+		return diag.FromErr(NotSupportClassic("resource `ncloud_nat_gateway`"))
 	}
 
 	reqParams := &vpc.CreateNatGatewayInstanceRequest{
@@ -86,7 +87,7 @@ func resourceNcloudNatGatewayCreate(d *schema.ResourceData, meta interface{}) er
 	resp, err := config.Client.vpc.V2Api.CreateNatGatewayInstance(reqParams)
 	if err != nil {
 		logErrorResponse("CreateNatGatewayInstance", err, reqParams)
-		return err
+		return ToDiagnostics(err)
 	}
 
 	logResponse("CreateNatGatewayInstance", resp)
@@ -95,19 +96,19 @@ func resourceNcloudNatGatewayCreate(d *schema.ResourceData, meta interface{}) er
 	d.SetId(*instance.NatGatewayInstanceNo)
 	log.Printf("[INFO] NAT Gateway ID: %s", d.Id())
 
-	if err := waitForNcloudNatGatewayCreation(config, d.Id()); err != nil {
-		return err
+	if err := waitForNcloudNatGatewayCreation(ctx, config, d.Id()); err != nil {
+		return ToDiagnostics(err)
 	}
 
-	return resourceNcloudNatGatewayRead(d, meta)
+	return resourceNcloudNatGatewayRead(ctx, d, meta)
 }
 
-func resourceNcloudNatGatewayRead(d *schema.ResourceData, meta interface{}) error {
+func resourceNcloudNatGatewayRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	config := meta.(*ProviderConfig)
 
 	instance, err := getNatGatewayInstance(config, d.Id())
 	if err != nil {
-		return err
+		return ToDiagnostics(err)
 	}
 
 	if instance == nil {
@@ -126,19 +127,19 @@ func resourceNcloudNatGatewayRead(d *schema.ResourceData, meta interface{}) erro
 	return nil
 }
 
-func resourceNcloudNatGatewayUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceNcloudNatGatewayUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	config := meta.(*ProviderConfig)
 
 	if d.HasChange("description") {
 		if err := setNatGatewayDescription(d, config); err != nil {
-			return err
+			return ToDiagnostics(err)
 		}
 	}
 
-	return resourceNcloudNatGatewayRead(d, meta)
+	return resourceNcloudNatGatewayRead(ctx, d, meta)
 }
 
-func resourceNcloudNatGatewayDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceNcloudNatGatewayDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	config := meta.(*ProviderConfig)
 
 	reqParams := &vpc.DeleteNatGatewayInstanceRequest{
@@ -150,52 +151,54 @@ func resourceNcloudNatGatewayDelete(d *schema.ResourceData, meta interface{}) er
 	resp, err := config.Client.vpc.V2Api.DeleteNatGatewayInstance(reqParams)
 	if err != nil {
 		logErrorResponse("DeleteNatGatewayInstance", err, reqParams)
-		return err
+		return ToDiagnostics(err)
 	}
 
 	logResponse("DeleteNatGatewayInstance", resp)
 
-	if err := waitForNcloudNatGatewayDeletion(config, d.Id()); err != nil {
-		return err
+	if err := waitForNcloudNatGatewayDeletion(ctx, config, d.Id()); err != nil {
+		return ToDiagnostics(err)
 	}
 
 	return nil
 }
 
-func waitForNcloudNatGatewayCreation(config *ProviderConfig, id string) error {
-	stateConf := &resource.StateChangeConf{
+func waitForNcloudNatGatewayCreation(ctx context.Context, config *ProviderConfig, id string) error {
+	waiter := &Waiter[vpc.NatGatewayInstance]{
 		Pending: []string{"INIT", "CREATING"},
 		Target:  []string{"RUN"},
-		Refresh: func() (interface{}, string, error) {
-			instance, err := getNatGatewayInstance(config, id)
-			return VpcCommonStateRefreshFunc(instance, err, "NatGatewayInstanceStatus")
+		Refresh: func() (*vpc.NatGatewayInstance, error) {
+			return getNatGatewayInstance(config, id)
+		},
+		Status: func(instance *vpc.NatGatewayInstance) string {
+			return commonCodeValue(instance.NatGatewayInstanceStatus)
 		},
-		Timeout:    DefaultCreateTimeout,
-		Delay:      2 * time.Second,
-		MinTimeout: 3 * time.Second,
+		Timeout: DefaultCreateTimeout,
+		Delay:   2 * time.Second,
 	}
 
-	if _, err := stateConf.WaitForState(); err != nil {
+	if _, err := waiter.Wait(ctx); err != nil {
 		return fmt.Errorf("Error waiting for NAT Gateway (%s) to become available: %s", id, err)
 	}
 
 	return nil
 }
 
-func waitForNcloudNatGatewayDeletion(config *ProviderConfig, id string) error {
-	stateConf := &resource.StateChangeConf{
+func waitForNcloudNatGatewayDeletion(ctx context.Context, config *ProviderConfig, id string) error {
+	waiter := &Waiter[vpc.NatGatewayInstance]{
 		Pending: []string{"RUN", "TERMTING"},
 		Target:  []string{"TERMINATED"},
-		Refresh: func() (interface{}, string, error) {
-			instance, err := getNatGatewayInstance(config, id)
-			return VpcCommonStateRefreshFunc(instance, err, "NatGatewayInstanceStatus")
+		Refresh: func() (*vpc.NatGatewayInstance, error) {
+			return getNatGatewayInstance(config, id)
+		},
+		Status: func(instance *vpc.NatGatewayInstance) string {
+			return commonCodeValue(instance.NatGatewayInstanceStatus)
 		},
-		Timeout:    DefaultTimeout,
-		Delay:      2 * time.Second,
-		MinTimeout: 3 * time.Second,
+		Timeout: DefaultTimeout,
+		Delay:   2 * time.Second,
 	}
 
-	if _, err := stateConf.WaitForState(); err != nil {
+	if _, err := waiter.Wait(ctx); err != nil {
 		return fmt.Errorf("Error waiting for NAT Gateway (%s) to become termintaing: %s", id, err)
 	}
 
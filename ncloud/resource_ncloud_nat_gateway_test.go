@@ -1,6 +1,7 @@
 package ncloud
 
 import (
+	"context"
 	"errors"
 	"fmt"
 	"regexp"
@@ -250,7 +251,7 @@ func testAccCheckNatGatewayDisappears(instance *vpc.NatGatewayInstance) resource
 
 		_, err := config.Client.vpc.V2Api.DeleteNatGatewayInstance(reqParams)
 
-		if err := waitForNcloudNatGatewayDeletion(config, *instance.NatGatewayInstanceNo); err != nil {
+		if err := waitForNcloudNatGatewayDeletion(context.Background(), config, *instance.NatGatewayInstanceNo); err != nil {
 			return err
 		}
 
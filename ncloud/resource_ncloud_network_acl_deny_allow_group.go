@@ -1,14 +1,15 @@
 package ncloud
 
 import (
+	"context"
 	"fmt"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"log"
 	"time"
 
 	"github.com/NaverCloudPlatform/ncloud-sdk-go-v2/ncloud"
 	"github.com/NaverCloudPlatform/ncloud-sdk-go-v2/services/vpc"
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
@@ -18,12 +19,12 @@ func init() {
 
 func resourceNcloudNetworkACLDenyAllowGroup() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceNcloudNetworkACLDenyAllowGroupCreate,
-		Read:   resourceNcloudNetworkACLDenyAllowGroupRead,
-		Update: resourceNcloudNetworkACLDenyAllowGroupUpdate,
-		Delete: resourceNcloudNetworkACLDenyAllowGroupDelete,
+		CreateContext: resourceNcloudNetworkACLDenyAllowGroupCreate,
+		ReadContext:   resourceNcloudNetworkACLDenyAllowGroupRead,
+		UpdateContext: resourceNcloudNetworkACLDenyAllowGroupUpdate,
+		DeleteContext: resourceNcloudNetworkACLDenyAllowGroupDelete,
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
 		},
 		Schema: map[string]*schema.Schema{
 			"network_acl_deny_allow_group_no": {
@@ -59,11 +60,11 @@ func resourceNcloudNetworkACLDenyAllowGroup() *schema.Resource {
 	}
 }
 
-func resourceNcloudNetworkACLDenyAllowGroupCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceNcloudNetworkACLDenyAllowGroupCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	config := meta.(*ProviderConfig)
 
 	if !config.SupportVPC {
-		return NotSupportClassic("resource `ncloud_network_acl_deny_allow_group`")
+		return diag.FromErr(NotSupportClassic("resource `ncloud_network_acl_deny_allow_group`"))
 	}
 
 	reqParams := &vpc.CreateNetworkAclDenyAllowGroupRequest{
@@ -82,7 +83,7 @@ func resourceNcloudNetworkACLDenyAllowGroupCreate(d *schema.ResourceData, meta i
 	resp, err := config.Client.vpc.V2Api.CreateNetworkAclDenyAllowGroup(reqParams)
 	if err != nil {
 		logErrorResponse("CreateNetworkAclDenyAllowGroup", err, reqParams)
-		return err
+		return ToDiagnostics(err)
 	}
 
 	logResponse("CreateNetworkAclDenyAllowGroup", resp)
@@ -91,27 +92,27 @@ func resourceNcloudNetworkACLDenyAllowGroupCreate(d *schema.ResourceData, meta i
 	d.SetId(*instance.NetworkAclDenyAllowGroupNo)
 	log.Printf("[INFO] Network ACL DenyAllowGroup ID: %s", d.Id())
 
-	if err := waitForVpcNetworkAclDenyAllowGroupState(config, d.Id(), []string{InstanceStatusInit, InstanceStatusCreate}, []string{InstanceStatusRunning}, DefaultCreateTimeout); err != nil {
-		return err
+	if err := waitForVpcNetworkAclDenyAllowGroupState(ctx, config, d.Id(), []string{InstanceStatusInit, InstanceStatusCreate}, []string{InstanceStatusRunning}, DefaultCreateTimeout); err != nil {
+		return ToDiagnostics(err)
 	}
 
 	if err := setNetworkAclDenyAllowGroupIpList(d, config); err != nil {
-		return err
+		return ToDiagnostics(err)
 	}
 
-	if err := waitForVpcNetworkAclDenyAllowGroupState(config, d.Id(), []string{InstanceStatusSetting}, []string{InstanceStatusRunning}, DefaultCreateTimeout); err != nil {
-		return err
+	if err := waitForVpcNetworkAclDenyAllowGroupState(ctx, config, d.Id(), []string{InstanceStatusSetting}, []string{InstanceStatusRunning}, DefaultCreateTimeout); err != nil {
+		return ToDiagnostics(err)
 	}
 
-	return resourceNcloudNetworkACLDenyAllowGroupRead(d, meta)
+	return resourceNcloudNetworkACLDenyAllowGroupRead(ctx, d, meta)
 }
 
-func resourceNcloudNetworkACLDenyAllowGroupRead(d *schema.ResourceData, meta interface{}) error {
+func resourceNcloudNetworkACLDenyAllowGroupRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	config := meta.(*ProviderConfig)
 
 	instance, err := getNetworkAclDenyAllowGroupDetail(config, d.Id())
 	if err != nil {
-		return err
+		return ToDiagnostics(err)
 	}
 
 	if instance == nil {
@@ -133,29 +134,29 @@ func resourceNcloudNetworkACLDenyAllowGroupRead(d *schema.ResourceData, meta int
 	return nil
 }
 
-func resourceNcloudNetworkACLDenyAllowGroupUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceNcloudNetworkACLDenyAllowGroupUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	config := meta.(*ProviderConfig)
 
 	if d.HasChange("ip_list") {
 		if err := setNetworkAclDenyAllowGroupIpList(d, config); err != nil {
-			return err
+			return ToDiagnostics(err)
 		}
 	}
 
 	if d.HasChange("description") {
 		if err := setNetworkAclDenyAllowGroupDescription(d, config); err != nil {
-			return err
+			return ToDiagnostics(err)
 		}
 	}
 
-	if err := waitForVpcNetworkAclDenyAllowGroupState(config, d.Id(), []string{InstanceStatusSetting}, []string{InstanceStatusRunning}, DefaultTimeout); err != nil {
-		return err
+	if err := waitForVpcNetworkAclDenyAllowGroupState(ctx, config, d.Id(), []string{InstanceStatusSetting}, []string{InstanceStatusRunning}, DefaultTimeout); err != nil {
+		return ToDiagnostics(err)
 	}
 
-	return resourceNcloudNetworkACLDenyAllowGroupRead(d, meta)
+	return resourceNcloudNetworkACLDenyAllowGroupRead(ctx, d, meta)
 }
 
-func resourceNcloudNetworkACLDenyAllowGroupDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceNcloudNetworkACLDenyAllowGroupDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	config := meta.(*ProviderConfig)
 
 	reqParams := &vpc.DeleteNetworkAclDenyAllowGroupRequest{
@@ -167,32 +168,33 @@ func resourceNcloudNetworkACLDenyAllowGroupDelete(d *schema.ResourceData, meta i
 	resp, err := config.Client.vpc.V2Api.DeleteNetworkAclDenyAllowGroup(reqParams)
 	if err != nil {
 		logErrorResponse("DeleteNetworkAclDenyAllowGroup", err, reqParams)
-		return err
+		return ToDiagnostics(err)
 	}
 
 	logResponse("DeleteNetworkAclDenyAllowGroup", resp)
 
-	if err := waitForVpcNetworkAclDenyAllowGroupState(config, d.Id(), []string{InstanceStatusRunning, InstanceStatusTerminating}, []string{InstanceStatusTerminated}, DefaultTimeout); err != nil {
-		return err
+	if err := waitForVpcNetworkAclDenyAllowGroupState(ctx, config, d.Id(), []string{InstanceStatusRunning, InstanceStatusTerminating}, []string{InstanceStatusTerminated}, DefaultTimeout); err != nil {
+		return ToDiagnostics(err)
 	}
 
 	return nil
 }
 
-func waitForVpcNetworkAclDenyAllowGroupState(config *ProviderConfig, id string, pending []string, target []string, timeout time.Duration) error {
-	stateConf := &resource.StateChangeConf{
+func waitForVpcNetworkAclDenyAllowGroupState(ctx context.Context, config *ProviderConfig, id string, pending []string, target []string, timeout time.Duration) error {
+	waiter := &Waiter[vpc.NetworkAclDenyAllowGroup]{
 		Pending: pending,
 		Target:  target,
-		Refresh: func() (interface{}, string, error) {
-			instance, err := getNetworkAclDenyAllowGroupDetail(config, id)
-			return VpcCommonStateRefreshFunc(instance, err, "NetworkAclDenyAllowGroupStatus")
+		Refresh: func() (*vpc.NetworkAclDenyAllowGroup, error) {
+			return getNetworkAclDenyAllowGroupDetail(config, id)
+		},
+		Status: func(instance *vpc.NetworkAclDenyAllowGroup) string {
+			return commonCodeValue(instance.NetworkAclDenyAllowGroupStatus)
 		},
-		Timeout:    timeout,
-		Delay:      2 * time.Second,
-		MinTimeout: 3 * time.Second,
+		Timeout: timeout,
+		Delay:   2 * time.Second,
 	}
 
-	_, err := stateConf.WaitForState()
+	_, err := waiter.Wait(ctx)
 	if err != nil {
 		return fmt.Errorf("error waiting for NetworkAclDenyAllowGroupStatus (%s) to become (%v): %s", id, target, err)
 	}
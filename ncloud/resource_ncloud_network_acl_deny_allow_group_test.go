@@ -1,6 +1,7 @@
 package ncloud
 
 import (
+	"context"
 	"errors"
 	"fmt"
 	"regexp"
@@ -221,7 +222,7 @@ func testAccCheckNetworkACLDenyAllowGroupDisappears(instance *vpc.NetworkAclDeny
 
 		_, err := config.Client.vpc.V2Api.DeleteNetworkAclDenyAllowGroup(reqParams)
 
-		if err := waitForNcloudNetworkACLDeletion(config, *instance.NetworkAclDenyAllowGroupNo); err != nil {
+		if err := waitForNcloudNetworkACLDeletion(context.Background(), config, *instance.NetworkAclDenyAllowGroupNo); err != nil {
 			return err
 		}
 
@@ -1,10 +1,12 @@
 package ncloud
 
 import (
+	"context"
 	"fmt"
 	"log"
 	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 
 	"github.com/NaverCloudPlatform/ncloud-sdk-go-v2/ncloud"
@@ -19,10 +21,13 @@ func init() {
 
 func resourceNcloudNetworkACLRule() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceNcloudNetworkACLRuleCreate,
-		Read:   resourceNcloudNetworkACLRuleRead,
-		Update: resourceNcloudNetworkACLRuleUpdate,
-		Delete: resourceNcloudNetworkACLRuleDelete,
+		CreateContext: resourceNcloudNetworkACLRuleCreate,
+		ReadContext:   resourceNcloudNetworkACLRuleRead,
+		UpdateContext: resourceNcloudNetworkACLRuleUpdate,
+		DeleteContext: resourceNcloudNetworkACLRuleDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
 		Schema: map[string]*schema.Schema{
 			"network_acl_no": {
 				Type:     schema.TypeString,
@@ -123,29 +128,28 @@ func resourceNcloudNetworkACLRule() *schema.Resource {
 	}
 }
 
-func resourceNcloudNetworkACLRuleCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceNcloudNetworkACLRuleCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	config := meta.(*ProviderConfig)
 
 	if !config.SupportVPC {
-		return NotSupportClassic("resource `ncloud_network_acl_rule`")
+		return diag.FromErr(NotSupportClassic("resource `ncloud_network_acl_rule`"))
 	}
 
 	d.SetId(d.Get("network_acl_no").(string))
 	log.Printf("[INFO] Network ACL ID: %s", d.Id())
 
-	return resourceNcloudNetworkACLRuleUpdate(d, meta)
+	return resourceNcloudNetworkACLRuleUpdate(ctx, d, meta)
 }
 
-func resourceNcloudNetworkACLRuleRead(d *schema.ResourceData, meta interface{}) error {
+func resourceNcloudNetworkACLRuleRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	config := meta.(*ProviderConfig)
 
 	rules, err := getNetworkACLRuleList(config, d.Id())
 	if err != nil {
-		errBody, _ := GetCommonErrorBody(err)
-		if errBody.ReturnCode == "1011002" { // You cannot access the appropriate Network ACL
+		if apiErrorCode(err) == "1011002" { // You cannot access the appropriate Network ACL
 			d.SetId("")
 		}
-		return err
+		return ToDiagnostics(err)
 	}
 
 	if len(rules) == 0 {
@@ -178,70 +182,74 @@ func resourceNcloudNetworkACLRuleRead(d *schema.ResourceData, meta interface{})
 	}
 
 	// Only set data intersection between resource and list
+	var diags diag.Diagnostics
 	if err := d.Set("inbound", iSet.List()); err != nil {
 		log.Printf("[WARN] Error setting inbound rule set for (%s): %s", d.Id(), err)
+		diags = append(diags, attributeWarning("inbound", "Error setting inbound rule set", err))
 	}
 
 	if err := d.Set("outbound", oSet.List()); err != nil {
 		log.Printf("[WARN] Error setting outbound rule set for (%s): %s", d.Id(), err)
+		diags = append(diags, attributeWarning("outbound", "Error setting outbound rule set", err))
 	}
 
-	return nil
+	return diags
 }
 
-func resourceNcloudNetworkACLRuleUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceNcloudNetworkACLRuleUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	config := meta.(*ProviderConfig)
 
 	if d.HasChange("inbound") {
-		if err := updateNetworkACLRule(d, config, "inbound"); err != nil {
-			return err
+		if err := updateNetworkACLRule(ctx, d, config, "inbound"); err != nil {
+			return ToDiagnostics(err)
 		}
 	}
 
 	if d.HasChange("outbound") {
-		if err := updateNetworkACLRule(d, config, "outbound"); err != nil {
-			return err
+		if err := updateNetworkACLRule(ctx, d, config, "outbound"); err != nil {
+			return ToDiagnostics(err)
 		}
 	}
 
-	return resourceNcloudNetworkACLRuleRead(d, meta)
+	return resourceNcloudNetworkACLRuleRead(ctx, d, meta)
 }
 
-func resourceNcloudNetworkACLRuleDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceNcloudNetworkACLRuleDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	config := meta.(*ProviderConfig)
 
 	i := d.Get("inbound").(*schema.Set)
 	o := d.Get("outbound").(*schema.Set)
 
 	if len(i.List()) > 0 {
-		if err := removeNetworkACLRule(d, config, "inbound", expandRemoveNetworkAclRule(i.List())); err != nil {
-			return err
+		if err := removeNetworkACLRule(ctx, d, config, "inbound", expandRemoveNetworkAclRule(i.List())); err != nil {
+			return ToDiagnostics(err)
 		}
 	}
 
 	if len(o.List()) > 0 {
-		if err := removeNetworkACLRule(d, config, "outbound", expandRemoveNetworkAclRule(o.List())); err != nil {
-			return err
+		if err := removeNetworkACLRule(ctx, d, config, "outbound", expandRemoveNetworkAclRule(o.List())); err != nil {
+			return ToDiagnostics(err)
 		}
 	}
 
 	return nil
 }
 
-func waitForNcloudNetworkACLRunning(config *ProviderConfig, id string) error {
-	stateConf := &resource.StateChangeConf{
+func waitForNcloudNetworkACLRunning(ctx context.Context, config *ProviderConfig, id string) error {
+	waiter := &Waiter[vpc.NetworkAcl]{
 		Pending: []string{"SET"},
 		Target:  []string{"RUN"},
-		Refresh: func() (interface{}, string, error) {
-			instance, err := getNetworkACLInstance(config, id)
-			return VpcCommonStateRefreshFunc(instance, err, "NetworkAclStatus")
+		Refresh: func() (*vpc.NetworkAcl, error) {
+			return getNetworkACLInstance(config, id)
+		},
+		Status: func(instance *vpc.NetworkAcl) string {
+			return commonCodeValue(instance.NetworkAclStatus)
 		},
-		Timeout:    DefaultTimeout,
-		Delay:      2 * time.Second,
-		MinTimeout: 3 * time.Second,
+		Timeout: DefaultTimeout,
+		Delay:   2 * time.Second,
 	}
 
-	if _, err := stateConf.WaitForState(); err != nil {
+	if _, err := waiter.Wait(ctx); err != nil {
 		return fmt.Errorf("Error waiting for Network ACL (%s) to become termintaing: %s", id, err)
 	}
 
@@ -265,7 +273,7 @@ func getNetworkACLRuleList(config *ProviderConfig, id string) ([]*vpc.NetworkAcl
 	return resp.NetworkAclRuleList, nil
 }
 
-func updateNetworkACLRule(d *schema.ResourceData, config *ProviderConfig, ruleType string) error {
+func updateNetworkACLRule(ctx context.Context, d *schema.ResourceData, config *ProviderConfig, ruleType string) error {
 	o, n := d.GetChange(ruleType)
 
 	if o == nil {
@@ -285,13 +293,13 @@ func updateNetworkACLRule(d *schema.ResourceData, config *ProviderConfig, ruleTy
 	addNetworkACLRuleList := expandAddNetworkAclRule(add)
 
 	if len(removeNetworkACLRuleList) > 0 {
-		if err := removeNetworkACLRule(d, config, ruleType, removeNetworkACLRuleList); err != nil {
+		if err := removeNetworkACLRule(ctx, d, config, ruleType, removeNetworkACLRuleList); err != nil {
 			return err
 		}
 	}
 
 	if len(addNetworkACLRuleList) > 0 {
-		if err := addNetworkACLRule(d, config, ruleType, addNetworkACLRuleList); err != nil {
+		if err := addNetworkACLRule(ctx, d, config, ruleType, addNetworkACLRuleList); err != nil {
 			return err
 		}
 	}
@@ -299,11 +307,11 @@ func updateNetworkACLRule(d *schema.ResourceData, config *ProviderConfig, ruleTy
 	return nil
 }
 
-func addNetworkACLRule(d *schema.ResourceData, config *ProviderConfig, ruleType string, addNetworkRuleList []*vpc.AddNetworkAclRuleParameter) error {
+func addNetworkACLRule(ctx context.Context, d *schema.ResourceData, config *ProviderConfig, ruleType string, addNetworkRuleList []*vpc.AddNetworkAclRuleParameter) error {
 	var reqParams interface{}
 	var resp interface{}
 
-	err := resource.Retry(d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
+	err := resource.RetryContext(ctx, d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
 		var err error
 
 		if ruleType == "inbound" {
@@ -327,11 +335,9 @@ func addNetworkACLRule(d *schema.ResourceData, config *ProviderConfig, ruleType
 		}
 
 		if err != nil {
-			errBody, _ := GetCommonErrorBody(err)
-			if containsInStringList(errBody.ReturnCode, []string{ApiErrorNetworkAclCantAccessaApropriate, ApiErrorNetworkAclRuleChangeIngRules}) {
+			if containsInStringList(apiErrorCode(err), []string{ApiErrorNetworkAclCantAccessaApropriate, ApiErrorNetworkAclRuleChangeIngRules}) {
 				logErrorResponse("retry AddNetworkAclRule", err, reqParams)
-				time.Sleep(time.Second * 5)
-				return resource.RetryableError(err)
+				return retryableErrorAfter(ctx, time.Second*5, err)
 			}
 			return resource.NonRetryableError(err)
 		}
@@ -345,18 +351,18 @@ func addNetworkACLRule(d *schema.ResourceData, config *ProviderConfig, ruleType
 
 	logResponse("AddNetworkAclRule", resp)
 
-	if err = waitForNcloudNetworkACLRunning(config, d.Id()); err != nil {
+	if err = waitForNcloudNetworkACLRunning(ctx, config, d.Id()); err != nil {
 		return err
 	}
 
 	return nil
 }
 
-func removeNetworkACLRule(d *schema.ResourceData, config *ProviderConfig, ruleType string, removeNetworkRuleList []*vpc.RemoveNetworkAclRuleParameter) error {
+func removeNetworkACLRule(ctx context.Context, d *schema.ResourceData, config *ProviderConfig, ruleType string, removeNetworkRuleList []*vpc.RemoveNetworkAclRuleParameter) error {
 	var reqParams interface{}
 	var resp interface{}
 
-	err := resource.Retry(d.Timeout(schema.TimeoutDelete), func() *resource.RetryError {
+	err := resource.RetryContext(ctx, d.Timeout(schema.TimeoutDelete), func() *resource.RetryError {
 		var err error
 
 		if ruleType == "inbound" {
@@ -380,11 +386,9 @@ func removeNetworkACLRule(d *schema.ResourceData, config *ProviderConfig, ruleTy
 		}
 
 		if err != nil {
-			errBody, _ := GetCommonErrorBody(err)
-			if containsInStringList(errBody.ReturnCode, []string{ApiErrorNetworkAclCantAccessaApropriate, ApiErrorNetworkAclRuleChangeIngRules}) {
+			if containsInStringList(apiErrorCode(err), []string{ApiErrorNetworkAclCantAccessaApropriate, ApiErrorNetworkAclRuleChangeIngRules}) {
 				logErrorResponse("retry RemoveNetworkAclRule", err, reqParams)
-				time.Sleep(time.Second * 5)
-				return resource.RetryableError(err)
+				return retryableErrorAfter(ctx, time.Second*5, err)
 			}
 			return resource.NonRetryableError(err)
 		}
@@ -398,7 +402,7 @@ func removeNetworkACLRule(d *schema.ResourceData, config *ProviderConfig, ruleTy
 
 	logResponse("RemoveNetworkAclRule", resp)
 
-	if err = waitForNcloudNetworkACLRunning(config, d.Id()); err != nil {
+	if err = waitForNcloudNetworkACLRunning(ctx, config, d.Id()); err != nil {
 		return err
 	}
 
@@ -32,6 +32,11 @@ func TestAccResourceNcloudNetworkACLRule_basic(t *testing.T) {
 					resource.TestCheckResourceAttr(resourceName, "outbound.#", "1"),
 				),
 			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
 		},
 	})
 }
@@ -256,8 +261,7 @@ func testAccCheckNetworkACLRuleDestroy(s *terraform.State) error {
 		}
 
 		rules, err := getNetworkACLRuleList(config, rs.Primary.Attributes["network_acl_no"])
-		errBody, _ := GetCommonErrorBody(err)
-		if errBody.ReturnCode == ApiErrorNetworkAclCantAccessaApropriate {
+		if apiErrorCode(err) == ApiErrorNetworkAclCantAccessaApropriate {
 			return nil
 		}
 
@@ -1,7 +1,9 @@
 package ncloud
 
 import (
+	"context"
 	"fmt"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"log"
 	"time"
@@ -26,12 +28,12 @@ const (
 
 func resourceNcloudNetworkInterface() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceNcloudNetworkInterfaceCreate,
-		Read:   resourceNcloudNetworkInterfaceRead,
-		Update: resourceNcloudNetworkInterfaceUpdate,
-		Delete: resourceNcloudNetworkInterfaceDelete,
+		CreateContext: resourceNcloudNetworkInterfaceCreate,
+		ReadContext:   resourceNcloudNetworkInterfaceRead,
+		UpdateContext: resourceNcloudNetworkInterfaceUpdate,
+		DeleteContext: resourceNcloudNetworkInterfaceDelete,
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
 		},
 		Schema: map[string]*schema.Schema{
 			"subnet_no": {
@@ -90,33 +92,33 @@ func resourceNcloudNetworkInterface() *schema.Resource {
 	}
 }
 
-func resourceNcloudNetworkInterfaceCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceNcloudNetworkInterfaceCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	config := meta.(*ProviderConfig)
 
 	instance, err := createNetworkInterface(d, config)
 
 	if err != nil {
-		return err
+		return ToDiagnostics(err)
 	}
 
 	d.SetId(*instance.NetworkInterfaceNo)
 	log.Printf("[INFO] Network Interface ID: %s", d.Id())
 
 	if v, ok := d.GetOk("server_instance_no"); ok && v != "" {
-		if err := waitForNetworkInterfaceAttachment(config, d.Id()); err != nil {
-			return err
+		if err := waitForNetworkInterfaceAttachment(ctx, config, d.Id()); err != nil {
+			return ToDiagnostics(err)
 		}
 	}
 
-	return resourceNcloudNetworkInterfaceRead(d, meta)
+	return resourceNcloudNetworkInterfaceRead(ctx, d, meta)
 }
 
-func resourceNcloudNetworkInterfaceRead(d *schema.ResourceData, meta interface{}) error {
+func resourceNcloudNetworkInterfaceRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	config := meta.(*ProviderConfig)
 
-	instance, err := getNetworkInterface(config, d.Id())
+	instance, err := config.readNetworkInterface(d.Id())
 	if err != nil {
-		return err
+		return ToDiagnostics(err)
 	}
 
 	if instance == nil {
@@ -142,20 +144,20 @@ func resourceNcloudNetworkInterfaceRead(d *schema.ResourceData, meta interface{}
 	return nil
 }
 
-func resourceNcloudNetworkInterfaceUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceNcloudNetworkInterfaceUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	config := meta.(*ProviderConfig)
 
 	if d.HasChange("server_instance_no") {
 		o, n := d.GetChange("server_instance_no")
 		if len(o.(string)) > 0 {
-			if err := detachNetworkInterface(d, config, o.(string)); err != nil {
-				return err
+			if err := detachNetworkInterface(ctx, d, config, o.(string)); err != nil {
+				return ToDiagnostics(err)
 			}
 		}
 
 		if len(n.(string)) > 0 {
-			if err := attachNetworkInterface(d, config); err != nil {
-				return err
+			if err := attachNetworkInterface(ctx, d, config); err != nil {
+				return ToDiagnostics(err)
 			}
 		}
 	}
@@ -173,26 +175,26 @@ func resourceNcloudNetworkInterfaceUpdate(d *schema.ResourceData, meta interface
 
 		// First do add ACG prevent error '[1002035] At least one Acg must remain on the network interface.'
 		if len(addAcgList) > 0 {
-			if err := addNetworkInterfaceAccessControlGroup(d, config, addAcgList); err != nil {
-				return err
+			if err := addNetworkInterfaceAccessControlGroup(ctx, d, config, addAcgList); err != nil {
+				return ToDiagnostics(err)
 			}
 		}
 
 		if len(removeAcgList) > 0 {
-			if err := removeNetworkInterfaceAccessControlGroup(d, config, removeAcgList); err != nil {
-				return err
+			if err := removeNetworkInterfaceAccessControlGroup(ctx, d, config, removeAcgList); err != nil {
+				return ToDiagnostics(err)
 			}
 		}
 	}
 
-	return resourceNcloudNetworkInterfaceRead(d, meta)
+	return resourceNcloudNetworkInterfaceRead(ctx, d, meta)
 }
 
-func removeNetworkInterfaceAccessControlGroup(d *schema.ResourceData, config *ProviderConfig, accessControlGroupNoList []*string) error {
+func removeNetworkInterfaceAccessControlGroup(ctx context.Context, d *schema.ResourceData, config *ProviderConfig, accessControlGroupNoList []*string) error {
 	var resp *vserver.RemoveNetworkInterfaceAccessControlGroupResponse
 	var reqParams *vserver.RemoveNetworkInterfaceAccessControlGroupRequest
 
-	err := resource.Retry(d.Timeout(schema.TimeoutDelete), func() *resource.RetryError {
+	err := resource.RetryContext(ctx, d.Timeout(schema.TimeoutDelete), func() *resource.RetryError {
 		var err error
 		reqParams = &vserver.RemoveNetworkInterfaceAccessControlGroupRequest{
 			RegionCode:               &config.RegionCode,
@@ -204,11 +206,9 @@ func removeNetworkInterfaceAccessControlGroup(d *schema.ResourceData, config *Pr
 		resp, err = config.Client.vserver.V2Api.RemoveNetworkInterfaceAccessControlGroup(reqParams)
 
 		if err != nil {
-			errBody, _ := GetCommonErrorBody(err)
-			if errBody.ReturnCode == ApiErrorNetworkInterfaceAtLeastOneAcgMustRemain {
+			if apiErrorCode(err) == ApiErrorNetworkInterfaceAtLeastOneAcgMustRemain {
 				logErrorResponse("retry RemoveNetworkInterfaceAccessControlGroup", err, reqParams)
-				time.Sleep(time.Second * 5)
-				return resource.RetryableError(err)
+				return retryableErrorAfter(ctx, time.Second*5, err)
 			}
 			return resource.NonRetryableError(err)
 		}
@@ -222,14 +222,14 @@ func removeNetworkInterfaceAccessControlGroup(d *schema.ResourceData, config *Pr
 
 	logResponse("RemoveNetworkInterfaceAccessControlGroup", resp)
 
-	if err = waitForVpcNetworkInterfaceState(config, d.Id(), []string{NetworkInterfaceStateSet}, []string{NetworkInterfaceStateNotUsed, NetworkInterfaceStateUsed}); err != nil {
+	if err = waitForVpcNetworkInterfaceState(ctx, config, d.Id(), []string{NetworkInterfaceStateSet}, []string{NetworkInterfaceStateNotUsed, NetworkInterfaceStateUsed}); err != nil {
 		return err
 	}
 
 	return nil
 }
 
-func addNetworkInterfaceAccessControlGroup(d *schema.ResourceData, config *ProviderConfig, accessControlGroupNoList []*string) error {
+func addNetworkInterfaceAccessControlGroup(ctx context.Context, d *schema.ResourceData, config *ProviderConfig, accessControlGroupNoList []*string) error {
 	reqParams := &vserver.AddNetworkInterfaceAccessControlGroupRequest{
 		RegionCode:               &config.RegionCode,
 		AccessControlGroupNoList: accessControlGroupNoList,
@@ -246,18 +246,18 @@ func addNetworkInterfaceAccessControlGroup(d *schema.ResourceData, config *Provi
 
 	logResponse("AddNetworkInterfaceAccessControlGroup", resp)
 
-	if err = waitForVpcNetworkInterfaceState(config, d.Id(), []string{NetworkInterfaceStateSet}, []string{NetworkInterfaceStateNotUsed, NetworkInterfaceStateUsed}); err != nil {
+	if err = waitForVpcNetworkInterfaceState(ctx, config, d.Id(), []string{NetworkInterfaceStateSet}, []string{NetworkInterfaceStateNotUsed, NetworkInterfaceStateUsed}); err != nil {
 		return err
 	}
 
 	return nil
 }
 
-func resourceNcloudNetworkInterfaceDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceNcloudNetworkInterfaceDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	config := meta.(*ProviderConfig)
 
-	if err := deleteNetworkInterface(config, d.Id()); err != nil {
-		return err
+	if err := deleteNetworkInterface(ctx, config, d.Id()); err != nil {
+		return ToDiagnostics(err)
 	}
 
 	return nil
@@ -292,6 +292,24 @@ func getVpcNetworkInterface(config *ProviderConfig, id string) (*vserver.Network
 	return nil, nil
 }
 
+// getVpcNetworkInterfaceList returns the network interfaces found of the numbers
+func getVpcNetworkInterfaceList(config *ProviderConfig, ids []string) ([]*vserver.NetworkInterface, error) {
+	reqParams := &vserver.GetNetworkInterfaceListRequest{
+		RegionCode:             &config.RegionCode,
+		NetworkInterfaceNoList: ncloud.StringList(ids),
+	}
+
+	logCommonRequest("getVpcNetworkInterfaceList", reqParams)
+	resp, err := config.Client.vserver.V2Api.GetNetworkInterfaceList(reqParams)
+	if err != nil {
+		logErrorResponse("getVpcNetworkInterfaceList", err, reqParams)
+		return nil, err
+	}
+	logResponse("getVpcNetworkInterfaceList", resp)
+
+	return resp.NetworkInterfaceList, nil
+}
+
 func createNetworkInterface(d *schema.ResourceData, config *ProviderConfig) (*vserver.NetworkInterface, error) {
 	if config.SupportVPC {
 		return createVpcNetworkInterface(d, config)
@@ -332,15 +350,15 @@ func createVpcNetworkInterface(d *schema.ResourceData, config *ProviderConfig) (
 	return resp.NetworkInterfaceList[0], nil
 }
 
-func deleteNetworkInterface(config *ProviderConfig, id string) error {
+func deleteNetworkInterface(ctx context.Context, config *ProviderConfig, id string) error {
 	if config.SupportVPC {
-		return deleteVpcNetworkInterface(config, id)
+		return deleteVpcNetworkInterface(ctx, config, id)
 	}
 
 	return NotSupportClassic("resource `ncloud_network_interface`")
 }
 
-func deleteVpcNetworkInterface(config *ProviderConfig, id string) error {
+func deleteVpcNetworkInterface(ctx context.Context, config *ProviderConfig, id string) error {
 	reqParams := &vserver.DeleteNetworkInterfaceRequest{
 		RegionCode:         &config.RegionCode,
 		NetworkInterfaceNo: ncloud.String(id),
@@ -354,18 +372,18 @@ func deleteVpcNetworkInterface(config *ProviderConfig, id string) error {
 	}
 	logResponse("deleteVpcNetworkInterface", resp)
 
-	if err := waitForVpcNetworkInterfaceState(config, id, []string{NetworkInterfaceStateUsed, NetworkInterfaceStateNotUsed, NetworkInterfaceStateUnSet}, []string{NetworkInterfaceStateTerminated}); err != nil {
+	if err := waitForVpcNetworkInterfaceState(ctx, config, id, []string{NetworkInterfaceStateUsed, NetworkInterfaceStateNotUsed, NetworkInterfaceStateUnSet}, []string{NetworkInterfaceStateTerminated}); err != nil {
 		return err
 	}
 
 	return nil
 }
 
-func attachNetworkInterface(d *schema.ResourceData, config *ProviderConfig) error {
+func attachNetworkInterface(ctx context.Context, d *schema.ResourceData, config *ProviderConfig) error {
 	var err error
 
 	if config.SupportVPC {
-		err = attachVpcNetworkInterface(d, config)
+		err = attachVpcNetworkInterface(ctx, d, config)
 	} else {
 		err = NotSupportClassic("resource `ncloud_network_interface`")
 	}
@@ -374,14 +392,14 @@ func attachNetworkInterface(d *schema.ResourceData, config *ProviderConfig) erro
 		return err
 	}
 
-	if err := waitForPublicIpDisassociation(config, d.Id()); err != nil {
+	if err := waitForPublicIpDisassociation(ctx, config, d.Id()); err != nil {
 		return err
 	}
 
 	return nil
 }
 
-func attachVpcNetworkInterface(d *schema.ResourceData, config *ProviderConfig) error {
+func attachVpcNetworkInterface(ctx context.Context, d *schema.ResourceData, config *ProviderConfig) error {
 	reqParams := &vserver.AttachNetworkInterfaceRequest{
 		RegionCode:         &config.RegionCode,
 		NetworkInterfaceNo: ncloud.String(d.Id()),
@@ -398,18 +416,18 @@ func attachVpcNetworkInterface(d *schema.ResourceData, config *ProviderConfig) e
 	}
 	logCommonResponse("attachVpcNetworkInterface", GetCommonResponse(resp))
 
-	if err := waitForNetworkInterfaceAttachment(config, d.Id()); err != nil {
+	if err := waitForNetworkInterfaceAttachment(ctx, config, d.Id()); err != nil {
 		return err
 	}
 
 	return nil
 }
 
-func detachNetworkInterface(d *schema.ResourceData, config *ProviderConfig, serverInstanceNo string) error {
+func detachNetworkInterface(ctx context.Context, d *schema.ResourceData, config *ProviderConfig, serverInstanceNo string) error {
 	var err error
 
 	if config.SupportVPC {
-		err = detachVpcNetworkInterface(d, config, serverInstanceNo)
+		err = detachVpcNetworkInterface(ctx, d, config, serverInstanceNo)
 	} else {
 		err = NotSupportClassic("resource `ncloud_network_interface`")
 	}
@@ -421,7 +439,7 @@ func detachNetworkInterface(d *schema.ResourceData, config *ProviderConfig, serv
 	return nil
 }
 
-func detachVpcNetworkInterface(d *schema.ResourceData, config *ProviderConfig, serverInstanceNo string) error {
+func detachVpcNetworkInterface(ctx context.Context, d *schema.ResourceData, config *ProviderConfig, serverInstanceNo string) error {
 	reqParams := &vserver.DetachNetworkInterfaceRequest{
 		RegionCode:         &config.RegionCode,
 		NetworkInterfaceNo: ncloud.String(d.Id()),
@@ -438,18 +456,18 @@ func detachVpcNetworkInterface(d *schema.ResourceData, config *ProviderConfig, s
 	}
 	logCommonResponse("detachVpcNetworkInterface", GetCommonResponse(resp))
 
-	if err := waitForVpcNetworkInterfaceState(config, d.Id(), []string{NetworkInterfaceStateUnSet}, []string{NetworkInterfaceStateNotUsed}); err != nil {
+	if err := waitForVpcNetworkInterfaceState(ctx, config, d.Id(), []string{NetworkInterfaceStateUnSet}, []string{NetworkInterfaceStateNotUsed}); err != nil {
 		return err
 	}
 
 	return nil
 }
 
-func waitForNetworkInterfaceAttachment(config *ProviderConfig, id string) error {
+func waitForNetworkInterfaceAttachment(ctx context.Context, config *ProviderConfig, id string) error {
 	var err error
 
 	if config.SupportVPC {
-		err = waitForVpcNetworkInterfaceState(config, id, []string{NetworkInterfaceStateSet}, []string{NetworkInterfaceStateUsed})
+		err = waitForVpcNetworkInterfaceState(ctx, config, id, []string{NetworkInterfaceStateSet}, []string{NetworkInterfaceStateUsed})
 	} else {
 		err = NotSupportClassic("resource `ncloud_network_interface`")
 	}
@@ -461,20 +479,21 @@ func waitForNetworkInterfaceAttachment(config *ProviderConfig, id string) error
 	return nil
 }
 
-func waitForVpcNetworkInterfaceState(config *ProviderConfig, id string, pending []string, target []string) error {
-	stateConf := &resource.StateChangeConf{
+func waitForVpcNetworkInterfaceState(ctx context.Context, config *ProviderConfig, id string, pending []string, target []string) error {
+	waiter := &Waiter[vserver.NetworkInterface]{
 		Pending: pending,
 		Target:  target,
-		Refresh: func() (interface{}, string, error) {
-			instance, err := getNetworkInterface(config, id)
-			return VpcCommonStateRefreshFunc(instance, err, "NetworkInterfaceStatus")
+		Refresh: func() (*vserver.NetworkInterface, error) {
+			return getNetworkInterface(config, id)
+		},
+		Status: func(instance *vserver.NetworkInterface) string {
+			return commonCodeValue(instance.NetworkInterfaceStatus)
 		},
-		Timeout:    DefaultTimeout,
-		Delay:      2 * time.Second,
-		MinTimeout: 3 * time.Second,
+		Timeout: DefaultTimeout,
+		Delay:   2 * time.Second,
 	}
 
-	_, err := stateConf.WaitForState()
+	_, err := waiter.Wait(ctx)
 	if err != nil {
 		return fmt.Errorf("error waiting for Network Interface (%s) to become (%v): %s", id, target, err)
 	}
@@ -1,6 +1,7 @@
 package ncloud
 
 import (
+	"context"
 	"errors"
 	"fmt"
 	"github.com/NaverCloudPlatform/ncloud-sdk-go-v2/services/vserver"
@@ -211,6 +212,6 @@ func testAccCheckNetworkInterfaceDestroy(s *terraform.State) error {
 func testAccCheckNetworkInterfaceDisappears(instance *vserver.NetworkInterface) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		config := testAccProvider.Meta().(*ProviderConfig)
-		return deleteNetworkInterface(config, *instance.NetworkInterfaceNo)
+		return deleteNetworkInterface(context.Background(), config, *instance.NetworkInterfaceNo)
 	}
 }
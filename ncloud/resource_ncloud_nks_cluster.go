@@ -6,10 +6,10 @@ import (
 	"github.com/NaverCloudPlatform/ncloud-sdk-go-v2/ncloud"
 	"github.com/NaverCloudPlatform/ncloud-sdk-go-v2/services/vnks"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"log"
+	"sort"
 	"strconv"
 	"time"
 )
@@ -18,6 +18,11 @@ func init() {
 	RegisterResource("ncloud_nks_cluster", resourceNcloudNKSCluster())
 }
 
+const (
+	NKSIpAclActionAllow = "allow"
+	NKSIpAclActionDeny  = "deny"
+)
+
 const (
 	NKSStatusCreatingCode = "CREATING"
 	NKSStatusWorkingCode  = "WORKING"
@@ -31,6 +36,7 @@ func resourceNcloudNKSCluster() *schema.Resource {
 	return &schema.Resource{
 		CreateContext: resourceNcloudNKSClusterCreate,
 		ReadContext:   resourceNcloudNKSClusterRead,
+		UpdateContext: resourceNcloudNKSClusterUpdate,
 		DeleteContext: resourceNcloudNKSClusterDelete,
 		Importer: &schema.ResourceImporter{
 			StateContext: schema.ImportStatePassthroughContext,
@@ -69,7 +75,6 @@ func resourceNcloudNKSCluster() *schema.Resource {
 				Type:     schema.TypeString,
 				Optional: true,
 				Computed: true,
-				ForceNew: true,
 			},
 			"zone": {
 				Type:     schema.TypeString,
@@ -124,6 +129,71 @@ func resourceNcloudNKSCluster() *schema.Resource {
 					},
 				},
 			},
+			"ip_acl_default_action": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				Computed:         true,
+				ValidateDiagFunc: ToDiagFunc(validation.StringInSlice([]string{NKSIpAclActionAllow, NKSIpAclActionDeny}, false)),
+			},
+			"ip_acl": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"action": {
+							Type:             schema.TypeString,
+							Required:         true,
+							ValidateDiagFunc: ToDiagFunc(validation.StringInSlice([]string{NKSIpAclActionAllow, NKSIpAclActionDeny}, false)),
+						},
+						"address": {
+							Type:             schema.TypeString,
+							Required:         true,
+							ValidateDiagFunc: ToDiagFunc(validation.IsCIDR),
+						},
+						"comment": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+					},
+				},
+			},
+			"oidc": {
+				Type:     schema.TypeList,
+				MaxItems: 1,
+				Optional: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"issuer_url": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"client_id": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"username_claim": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+						"username_prefix": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+						"groups_claim": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+						"groups_prefix": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+						"required_claim": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+					},
+				},
+			},
 		},
 	}
 }
@@ -164,15 +234,29 @@ func resourceNcloudNKSClusterCreate(ctx context.Context, d *schema.ResourceData,
 	resp, err := config.Client.vnks.V2Api.ClustersPost(ctx, reqParams)
 	if err != nil {
 		logErrorResponse("resourceNcloudNKSClusterCreate", err, reqParams)
-		return diag.FromErr(err)
+		return ToDiagnostics(err)
 	}
 	uuid := ncloud.StringValue(resp.Uuid)
 
 	logResponse("resourceNcloudNKSClusterCreate", resp)
 	if err := waitForNKSClusterActive(ctx, d, config, uuid); err != nil {
-		return diag.FromErr(err)
+		return ToDiagnostics(err)
 	}
 	d.SetId(uuid)
+
+	if _, ok := d.GetOk("oidc"); ok {
+		if err := updateNKSClusterOidc(ctx, d, config); err != nil {
+			return ToDiagnostics(err)
+		}
+	}
+
+	_, hasDefaultAction := d.GetOk("ip_acl_default_action")
+	if _, ok := d.GetOk("ip_acl"); ok || hasDefaultAction {
+		if err := updateNKSClusterIpAcl(ctx, d, config); err != nil {
+			return ToDiagnostics(err)
+		}
+	}
+
 	return resourceNcloudNKSClusterRead(ctx, d, meta)
 }
 
@@ -184,7 +268,7 @@ func resourceNcloudNKSClusterRead(ctx context.Context, d *schema.ResourceData, m
 
 	cluster, err := getNKSCluster(ctx, config, d.Id())
 	if err != nil {
-		return diag.FromErr(err)
+		return ToDiagnostics(err)
 	}
 
 	if cluster == nil {
@@ -218,9 +302,77 @@ func resourceNcloudNKSClusterRead(ctx context.Context, d *schema.ResourceData, m
 		log.Printf("[WARN] Error setting subnet no list set for (%s): %s", d.Id(), err)
 	}
 
+	// Errors reading the settings fail the refresh only if they are managed, e.g. a cluster without OIDC is refreshed without permission for the API
+	oidc, err := getNKSClusterOidc(ctx, config, cluster.Uuid)
+	if err != nil {
+		if _, ok := d.GetOk("oidc"); ok {
+			return ToDiagnostics(err)
+		}
+		log.Printf("[WARN] Error reading oidc of NKS cluster (%s): %s", d.Id(), err)
+	} else if err := d.Set("oidc", flattenNKSClusterOidc(oidc)); err != nil {
+		log.Printf("[WARN] Error setting oidc for (%s): %s", d.Id(), err)
+	}
+
+	ipAcl, err := getNKSClusterIpAcl(ctx, config, cluster.Uuid)
+	if err != nil {
+		_, hasDefaultAction := d.GetOk("ip_acl_default_action")
+		if _, ok := d.GetOk("ip_acl"); ok || hasDefaultAction {
+			return ToDiagnostics(err)
+		}
+		log.Printf("[WARN] Error reading ip_acl of NKS cluster (%s): %s", d.Id(), err)
+	} else {
+		d.Set("ip_acl_default_action", ipAcl.DefaultAction)
+		if err := d.Set("ip_acl", flattenNKSClusterIpAclEntries(ipAcl.Entries)); err != nil {
+			log.Printf("[WARN] Error setting ip_acl for (%s): %s", d.Id(), err)
+		}
+	}
+
 	return nil
 }
 
+func resourceNcloudNKSClusterUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*ProviderConfig)
+	if !config.SupportVPC {
+		return diag.FromErr(NotSupportClassic("resource `ncloud_nks_cluster`"))
+	}
+
+	if d.HasChanges("k8s_version") {
+		if err := waitForNKSClusterActive(ctx, d, config, d.Id()); err != nil {
+			return ToDiagnostics(err)
+		}
+
+		k8sVersion := StringPtrOrNil(d.GetOk("k8s_version"))
+		logCommonRequest("resourceNcloudNKSClusterUpdate", k8sVersion)
+		if err := config.Client.vnksExt.ClustersUuidUpgradePatch(ctx, ncloud.String(d.Id()), k8sVersion); err != nil {
+			logErrorResponse("resourceNcloudNKSClusterUpdate", err, k8sVersion)
+			return ToDiagnostics(err)
+		}
+
+		logResponse("resourceNcloudNKSClusterUpdate", k8sVersion)
+		if err := waitForNKSClusterActive(ctx, d, config, d.Id()); err != nil {
+			return ToDiagnostics(err)
+		}
+
+		if err := upgradeNKSNodePools(ctx, d, config, d.Id()); err != nil {
+			return ToDiagnostics(err)
+		}
+	}
+
+	if d.HasChange("oidc") {
+		if err := updateNKSClusterOidc(ctx, d, config); err != nil {
+			return ToDiagnostics(err)
+		}
+	}
+
+	if d.HasChanges("ip_acl_default_action", "ip_acl") {
+		if err := updateNKSClusterIpAcl(ctx, d, config); err != nil {
+			return ToDiagnostics(err)
+		}
+	}
+
+	return resourceNcloudNKSClusterRead(ctx, d, meta)
+}
+
 func resourceNcloudNKSClusterDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	config := meta.(*ProviderConfig)
 	if !config.SupportVPC {
@@ -228,66 +380,146 @@ func resourceNcloudNKSClusterDelete(ctx context.Context, d *schema.ResourceData,
 	}
 
 	if err := waitForNKSClusterActive(ctx, d, config, d.Id()); err != nil {
-		return diag.FromErr(err)
+		return ToDiagnostics(err)
 	}
 
 	logCommonRequest("resourceNcloudNKSClusterDelete", d.Id())
 	if err := config.Client.vnks.V2Api.ClustersUuidDelete(ctx, ncloud.String(d.Id())); err != nil {
 		logErrorResponse("resourceNcloudNKSClusterDelete", err, d.Id())
-		return diag.FromErr(err)
+		return ToDiagnostics(err)
 	}
 
 	if err := waitForNKSClusterDeletion(ctx, d, config); err != nil {
-		return diag.FromErr(err)
+		return ToDiagnostics(err)
+	}
+
+	return nil
+}
+
+func updateNKSClusterOidc(ctx context.Context, d *schema.ResourceData, config *ProviderConfig) error {
+	if err := waitForNKSClusterActive(ctx, d, config, d.Id()); err != nil {
+		return err
+	}
+
+	reqParams := expandNKSClusterOidc(d.Get("oidc").([]interface{}))
+
+	logCommonRequest("updateNKSClusterOidc", reqParams)
+	if err := config.Client.vnksExt.ClustersUuidOidcPatch(ctx, reqParams, ncloud.String(d.Id())); err != nil {
+		logErrorResponse("updateNKSClusterOidc", err, reqParams)
+		return err
+	}
+
+	logResponse("updateNKSClusterOidc", reqParams)
+	return waitForNKSClusterActive(ctx, d, config, d.Id())
+}
+
+func updateNKSClusterIpAcl(ctx context.Context, d *schema.ResourceData, config *ProviderConfig) error {
+	if err := waitForNKSClusterActive(ctx, d, config, d.Id()); err != nil {
+		return err
+	}
+
+	reqParams := &NKSIpAclsRes{
+		DefaultAction: ncloud.String(NKSIpAclActionAllow),
+		Entries:       expandNKSClusterIpAclEntries(d.Get("ip_acl").(*schema.Set).List()),
+	}
+
+	if defaultAction, ok := d.GetOk("ip_acl_default_action"); ok {
+		reqParams.DefaultAction = ncloud.String(defaultAction.(string))
+	}
+
+	logCommonRequest("updateNKSClusterIpAcl", reqParams)
+	if err := config.Client.vnksExt.ClustersUuidIpAclPatch(ctx, reqParams, ncloud.String(d.Id())); err != nil {
+		logErrorResponse("updateNKSClusterIpAcl", err, reqParams)
+		return err
+	}
+
+	logResponse("updateNKSClusterIpAcl", reqParams)
+	return waitForNKSClusterActive(ctx, d, config, d.Id())
+}
+
+// upgradeNKSNodePools upgrades node pools of the cluster one by one to the control plane version
+func upgradeNKSNodePools(ctx context.Context, d *schema.ResourceData, config *ProviderConfig, uuid string) error {
+	cluster, err := getNKSCluster(ctx, config, uuid)
+	if err != nil {
+		return err
+	}
+
+	if cluster == nil {
+		return fmt.Errorf("NKS Cluster (%s) not found", uuid)
+	}
+
+	nodePools, err := getNKSNodePools(ctx, config, uuid)
+	if err != nil {
+		return err
+	}
+
+	sort.Slice(nodePools, func(i, j int) bool {
+		return ncloud.Int32Value(nodePools[i].InstanceNo) < ncloud.Int32Value(nodePools[j].InstanceNo)
+	})
+
+	for _, np := range nodePools {
+		if ncloud.StringValue(np.K8sVersion) == ncloud.StringValue(cluster.K8sVersion) {
+			continue
+		}
+
+		nodePoolName := ncloud.StringValue(np.Name)
+		if err := waitForNKSNodePoolActive(ctx, config, uuid, nodePoolName, d.Timeout(schema.TimeoutUpdate)); err != nil {
+			return err
+		}
+
+		instanceNo := ncloud.IntString(int(ncloud.Int32Value(np.InstanceNo)))
+		logCommonRequest("upgradeNKSNodePools", np)
+		if err := config.Client.vnksExt.ClustersUuidNodePoolInstanceNoUpgradePatch(ctx, ncloud.String(uuid), instanceNo, cluster.K8sVersion); err != nil {
+			logErrorResponse("upgradeNKSNodePools", err, np)
+			return err
+		}
+
+		logResponse("upgradeNKSNodePools", np)
+		if err := waitForNKSNodePoolActive(ctx, config, uuid, nodePoolName, d.Timeout(schema.TimeoutUpdate)); err != nil {
+			return err
+		}
 	}
 
 	return nil
 }
 
 func waitForNKSClusterDeletion(ctx context.Context, d *schema.ResourceData, config *ProviderConfig) error {
-	stateConf := &resource.StateChangeConf{
+	waiter := &Waiter[vnks.Cluster]{
+		Name:    fmt.Sprintf("NKS Cluster (%s)", d.Id()),
 		Pending: []string{NKSStatusDeletingCode},
 		Target:  []string{NKSStatusNullCode},
-		Refresh: func() (result interface{}, state string, err error) {
-			cluster, err := getNKSClusterFromList(ctx, config, d.Id())
-			if err != nil {
-				return nil, "", err
-			}
-			if cluster == nil {
-				return d.Id(), NKSStatusNullCode, nil
-			}
-			return cluster, ncloud.StringValue(cluster.Status), nil
+		Refresh: func() (*vnks.Cluster, error) {
+			return getNKSClusterFromList(ctx, config, d.Id())
 		},
-		Timeout:    d.Timeout(schema.TimeoutDelete),
-		MinTimeout: 3 * time.Second,
-		Delay:      2 * time.Second,
+		Status: func(cluster *vnks.Cluster) string {
+			return ncloud.StringValue(cluster.Status)
+		},
+		NotFoundStatus: NKSStatusNullCode,
+		Timeout:        d.Timeout(schema.TimeoutDelete),
+		Delay:          2 * time.Second,
 	}
-	if _, err := stateConf.WaitForStateContext(ctx); err != nil {
+	if _, err := waiter.Wait(ctx); err != nil {
 		return fmt.Errorf("Error waiting for NKS Cluster (%s) to become terminating: %s", d.Id(), err)
 	}
 	return nil
 }
 
 func waitForNKSClusterActive(ctx context.Context, d *schema.ResourceData, config *ProviderConfig, uuid string) error {
-	stateConf := &resource.StateChangeConf{
+	waiter := &Waiter[vnks.Cluster]{
+		Name:    fmt.Sprintf("NKS Cluster (%s)", uuid),
 		Pending: []string{NKSStatusCreatingCode, NKSStatusWorkingCode},
 		Target:  []string{NKSStatusRunningCode, NKSStatusNoNodeCode},
-		Refresh: func() (result interface{}, state string, err error) {
-			cluster, err := getNKSCluster(ctx, config, uuid)
-			if err != nil {
-				return nil, "", err
-			}
-			if cluster == nil {
-				return uuid, NKSStatusNullCode, nil
-			}
-			return cluster, ncloud.StringValue(cluster.Status), nil
-
+		Refresh: func() (*vnks.Cluster, error) {
+			return getNKSCluster(ctx, config, uuid)
 		},
-		Timeout:    d.Timeout(schema.TimeoutCreate),
-		MinTimeout: 3 * time.Second,
-		Delay:      2 * time.Second,
+		Status: func(cluster *vnks.Cluster) string {
+			return ncloud.StringValue(cluster.Status)
+		},
+		NotFoundStatus: NKSStatusNullCode,
+		Timeout:        d.Timeout(schema.TimeoutCreate),
+		Delay:          2 * time.Second,
 	}
-	if _, err := stateConf.WaitForStateContext(ctx); err != nil {
+	if _, err := waiter.Wait(ctx); err != nil {
 		return fmt.Errorf("error waiting for NKS Cluster (%s) to become activating: %s", uuid, err)
 	}
 	return nil
@@ -302,6 +534,24 @@ func getNKSCluster(ctx context.Context, config *ProviderConfig, uuid string) (*v
 	return resp.Cluster, nil
 }
 
+// getNKSClusterOidc returns OIDC configuration of the cluster, or the disabled one if the API is not supported for the cluster
+func getNKSClusterOidc(ctx context.Context, config *ProviderConfig, uuid *string) (*NKSOidcRes, error) {
+	oidc, err := config.Client.vnksExt.ClustersUuidOidcGet(ctx, uuid)
+	if isNKSNotSupportedError(err) {
+		return &NKSOidcRes{Status: ncloud.Bool(false)}, nil
+	}
+	return oidc, err
+}
+
+// getNKSClusterIpAcl returns IP ACL of the cluster API endpoint, or the empty one if the API is not supported for the cluster
+func getNKSClusterIpAcl(ctx context.Context, config *ProviderConfig, uuid *string) (*NKSIpAclsRes, error) {
+	ipAcl, err := config.Client.vnksExt.ClustersUuidIpAclGet(ctx, uuid)
+	if isNKSNotSupportedError(err) {
+		return &NKSIpAclsRes{}, nil
+	}
+	return ipAcl, err
+}
+
 func getNKSClusterFromList(ctx context.Context, config *ProviderConfig, uuid string) (*vnks.Cluster, error) {
 	clusters, err := getNKSClusters(ctx, config)
 	if err != nil {
@@ -86,6 +86,81 @@ func TestAccResourceNcloudNKSCluster_public_network(t *testing.T) {
 	})
 }
 
+func TestAccResourceNcloudNKSCluster_upgrade(t *testing.T) {
+	var before, after vnks.Cluster
+	name := getTestClusterName()
+	k8sVersion := "1.20"
+	upgradeK8sVersion := "1.21"
+	resourceName := "ncloud_nks_cluster.cluster"
+
+	region, clusterType, _ := getRegionAndNKSType()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckNKSClusterDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccResourceNcloudNKSClusterConfig(name, clusterType, k8sVersion, TF_TEST_NKS_LOGIN_KEY, region),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckNKSClusterExists(resourceName, &before),
+					resource.TestMatchResourceAttr(resourceName, "k8s_version", regexp.MustCompile(k8sVersion)),
+				),
+			},
+			{
+				Config: testAccResourceNcloudNKSClusterConfig(name, clusterType, upgradeK8sVersion, TF_TEST_NKS_LOGIN_KEY, region),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckNKSClusterExists(resourceName, &after),
+					testAccCheckNKSClusterNotRecreated(&before, &after),
+					resource.TestMatchResourceAttr(resourceName, "k8s_version", regexp.MustCompile(upgradeK8sVersion)),
+				),
+			},
+		},
+	})
+}
+
+func TestAccResourceNcloudNKSCluster_ipAclAndOidc(t *testing.T) {
+	var before, after vnks.Cluster
+	name := getTestClusterName()
+	k8sVersion := "1.21"
+	resourceName := "ncloud_nks_cluster.cluster"
+
+	region, clusterType, _ := getRegionAndNKSType()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckNKSClusterDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccResourceNcloudNKSClusterConfig(name, clusterType, k8sVersion, TF_TEST_NKS_LOGIN_KEY, region),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckNKSClusterExists(resourceName, &before),
+					resource.TestCheckResourceAttr(resourceName, "oidc.#", "0"),
+				),
+			},
+			{
+				Config: testAccResourceNcloudNKSClusterIpAclAndOidcConfig(name, clusterType, k8sVersion, TF_TEST_NKS_LOGIN_KEY, region),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckNKSClusterExists(resourceName, &after),
+					testAccCheckNKSClusterNotRecreated(&before, &after),
+					resource.TestCheckResourceAttr(resourceName, "ip_acl_default_action", "deny"),
+					resource.TestCheckResourceAttr(resourceName, "ip_acl.#", "1"),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "ip_acl.*", map[string]string{"action": "allow", "address": "10.0.1.0/24", "comment": "office"}),
+					resource.TestCheckResourceAttr(resourceName, "oidc.0.issuer_url", "https://sso.ntruss.com/iss"),
+					resource.TestCheckResourceAttr(resourceName, "oidc.0.client_id", "testClient"),
+					resource.TestCheckResourceAttr(resourceName, "oidc.0.username_claim", "email"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
 func TestAccResourceNcloudNKSCluster_InvalidSubnet(t *testing.T) {
 	name := getTestClusterName()
 	k8sVersion := "1.21"
@@ -167,6 +242,82 @@ resource "ncloud_nks_cluster" "cluster" {
 `, name, clusterType, k8sVersion, loginKeyName, region)
 }
 
+func testAccResourceNcloudNKSClusterIpAclAndOidcConfig(name string, clusterType string, k8sVersion string, loginKeyName string, region string) string {
+	return fmt.Sprintf(`
+resource "ncloud_vpc" "vpc" {
+	name               = "%[1]s"
+	ipv4_cidr_block    = "10.2.0.0/16"
+}
+
+resource "ncloud_subnet" "subnet1" {
+	vpc_no             = ncloud_vpc.vpc.vpc_no
+	name               = "%[1]s-1"
+	subnet             = "10.2.1.0/24"
+	zone               = "%[5]s-1"
+	network_acl_no     = ncloud_vpc.vpc.default_network_acl_no
+	subnet_type        = "PRIVATE"
+	usage_type         = "GEN"
+}
+
+resource "ncloud_subnet" "subnet2" {
+	vpc_no             = ncloud_vpc.vpc.vpc_no
+	name               = "%[1]s-2"
+	subnet             = "10.2.2.0/24"
+	zone               = "%[5]s-1"
+	network_acl_no     = ncloud_vpc.vpc.default_network_acl_no
+	subnet_type        = "PRIVATE"
+	usage_type         = "GEN"
+}
+
+resource "ncloud_subnet" "subnet_lb" {
+	vpc_no             = ncloud_vpc.vpc.vpc_no
+	name               = "%[1]s-lb"
+	subnet             = "10.2.100.0/24"
+	zone               = "%[5]s-1"
+	network_acl_no     = ncloud_vpc.vpc.default_network_acl_no
+	subnet_type        = "PRIVATE"
+	usage_type         = "LOADB"
+}
+
+data "ncloud_nks_versions" "version" {
+  filter {
+    name = "value"
+    values = ["%[3]s"]
+    regex = true
+  }
+}
+
+resource "ncloud_nks_cluster" "cluster" {
+  name                        = "%[1]s"
+  cluster_type                = "%[2]s"
+  k8s_version                 = data.ncloud_nks_versions.version.versions.0.value
+  login_key_name              = "%[4]s"
+  lb_private_subnet_no        = ncloud_subnet.subnet_lb.id
+  kube_network_plugin         = "cilium"
+  subnet_no_list              = [
+    ncloud_subnet.subnet1.id,
+    ncloud_subnet.subnet2.id,
+  ]
+  vpc_no                      = ncloud_vpc.vpc.vpc_no
+  zone                        = "%[5]s-1"
+
+  ip_acl_default_action       = "deny"
+  ip_acl {
+    action  = "allow"
+    address = "10.0.1.0/24"
+    comment = "office"
+  }
+
+  oidc {
+    issuer_url     = "https://sso.ntruss.com/iss"
+    client_id      = "testClient"
+    username_claim = "email"
+    groups_claim   = "groups"
+  }
+}
+`, name, clusterType, k8sVersion, loginKeyName, region)
+}
+
 func testAccResourceNcloudNKSClusterPublicNetworkConfig(name string, clusterType string, k8sVersion string, loginKeyName string, region string) string {
 	return fmt.Sprintf(`
 resource "ncloud_vpc" "vpc" {
@@ -318,6 +469,15 @@ func testAccCheckNKSClusterExists(n string, cluster *vnks.Cluster) resource.Test
 	}
 }
 
+func testAccCheckNKSClusterNotRecreated(before, after *vnks.Cluster) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		if ncloud.StringValue(before.Uuid) != ncloud.StringValue(after.Uuid) {
+			return fmt.Errorf("Cluster was recreated: %s -> %s", ncloud.StringValue(before.Uuid), ncloud.StringValue(after.Uuid))
+		}
+		return nil
+	}
+}
+
 func testAccCheckNKSClusterDestroy(s *terraform.State) error {
 	config := testAccProvider.Meta().(*ProviderConfig)
 
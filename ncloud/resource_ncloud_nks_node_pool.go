@@ -6,7 +6,6 @@ import (
 	"github.com/NaverCloudPlatform/ncloud-sdk-go-v2/ncloud"
 	"github.com/NaverCloudPlatform/ncloud-sdk-go-v2/services/vnks"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"log"
@@ -20,10 +19,15 @@ func init() {
 }
 
 const (
-	NKSNodePoolStatusRunCode       = "RUN"
-	NKSNodePoolStatusNodeScaleDown = "NODE_SCALE_DOWN"
-	NKSNodePoolStatusNodeScaleOut  = "NODE_SCALE_OUT"
-	NKSNodePoolIDSeparator         = ":"
+	NKSNodePoolStatusRunCode         = "RUN"
+	NKSNodePoolStatusNodeScaleDown   = "NODE_SCALE_DOWN"
+	NKSNodePoolStatusNodeScaleOut    = "NODE_SCALE_OUT"
+	NKSNodePoolStatusRotateScaleOut  = "ROTATE_NODE_SCALE_OUT"
+	NKSNodePoolStatusRotateScaleDown = "ROTATE_NODE_SCALE_DOWN"
+	NKSNodePoolStatusUpgrade         = "UPGRADE"
+	NKSNodePoolIDSeparator           = ":"
+	NKSNodePoolSurgeSuffix           = "-surge"
+	NKSNodePoolNameMaxLength         = 30
 )
 
 func resourceNcloudNKSNodePool() *schema.Resource {
@@ -32,6 +36,7 @@ func resourceNcloudNKSNodePool() *schema.Resource {
 		ReadContext:   resourceNcloudNKSNodePoolRead,
 		UpdateContext: resourceNcloudNKSNodePoolUpdate,
 		DeleteContext: resourceNcloudNKSNodePoolDelete,
+		CustomizeDiff: resourceNcloudNKSNodePoolCustomizeDiff,
 		Importer: &schema.ResourceImporter{
 			StateContext: schema.ImportStatePassthroughContext,
 		},
@@ -58,7 +63,7 @@ func resourceNcloudNKSNodePool() *schema.Resource {
 				Type:             schema.TypeString,
 				ForceNew:         true,
 				Required:         true,
-				ValidateDiagFunc: ToDiagFunc(validation.StringLenBetween(3, 30)),
+				ValidateDiagFunc: ToDiagFunc(validation.StringLenBetween(3, NKSNodePoolNameMaxLength)),
 			},
 			"node_count": {
 				Type:     schema.TypeInt,
@@ -73,6 +78,16 @@ func resourceNcloudNKSNodePool() *schema.Resource {
 				Type:     schema.TypeString,
 				Required: true,
 			},
+			"rolling_update": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+			"wait_for_nodes_ready": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
 			"autoscale": {
 				Type:     schema.TypeList,
 				Optional: true,
@@ -95,6 +110,43 @@ func resourceNcloudNKSNodePool() *schema.Resource {
 					},
 				},
 			},
+			"label": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"key": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"value": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+					},
+				},
+			},
+			"taint": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"key": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"value": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"effect": {
+							Type:             schema.TypeString,
+							Required:         true,
+							ValidateDiagFunc: ToDiagFunc(validation.StringInSlice([]string{"NoSchedule", "NoExecute", "PreferNoSchedule"}, false)),
+						},
+					},
+				},
+			},
 		},
 	}
 }
@@ -109,27 +161,10 @@ func resourceNcloudNKSNodePoolCreate(ctx context.Context, d *schema.ResourceData
 	nodePoolName := d.Get("node_pool_name").(string)
 	id := NodePoolCreateResourceID(clusterUuid, nodePoolName)
 
-	reqParams := &vnks.NodePoolCreationBody{
-		Name:        ncloud.String(nodePoolName),
-		NodeCount:   Int32PtrOrNil(d.GetOk("node_count")),
-		ProductCode: StringPtrOrNil(d.GetOk("product_code")),
-		SubnetNo:    getInt32FromString(d.GetOk("subnet_no")),
-	}
+	reqParams := expandNKSNodePoolCreationBody(d, nodePoolName)
 
-	if _, ok := d.GetOk("autoscale"); ok {
-		reqParams.Autoscale = expandNKSNodePoolAutoScale(d.Get("autoscale").([]interface{}))
-	}
-
-	logCommonRequest("resourceNcloudNKSNodePoolCreate", reqParams)
-	err := config.Client.vnks.V2Api.ClustersUuidNodePoolPost(ctx, reqParams, ncloud.String(clusterUuid))
-	if err != nil {
-		logErrorResponse("resourceNcloudNKSNodePoolCreate", err, reqParams)
-		return diag.FromErr(err)
-	}
-
-	logResponse("resourceNcloudNKSNodePoolCreate", reqParams)
-	if err := waitForNKSNodePoolActive(ctx, d, config, clusterUuid, ncloud.StringValue(reqParams.Name)); err != nil {
-		return diag.FromErr(err)
+	if err := createNKSNodePool(ctx, d, config, clusterUuid, reqParams, d.Timeout(schema.TimeoutCreate)); err != nil {
+		return ToDiagnostics(err)
 	}
 
 	d.SetId(id)
@@ -143,20 +178,31 @@ func resourceNcloudNKSNodePoolRead(ctx context.Context, d *schema.ResourceData,
 	}
 
 	clusterUuid, nodePoolName, err := NodePoolParseResourceID(d.Id())
-	nodePool, err := getNKSNodePool(ctx, config, clusterUuid, nodePoolName)
+	nodePools, err := getNKSNodePools(ctx, config, clusterUuid)
 	if err != nil {
-		return diag.FromErr(err)
+		return ToDiagnostics(err)
+	}
+	nodePool := findNKSNodePool(nodePools, nodePoolName)
+
+	// A surge node pool left by a failed rolling update. The previous product code is kept so that the next apply resumes the replacement.
+	resuming := d.Get("rolling_update").(bool) && findNKSNodePool(nodePools, nksSurgeNodePoolName(nodePoolName)) != nil
+	if resuming {
+		log.Printf("[WARN] Rolling update of NKS NodePool (%s) is incomplete, it's resumed on next apply", nodePoolName)
 	}
 
 	if nodePool == nil {
-		d.SetId("")
+		if !resuming {
+			d.SetId("")
+		}
 		return nil
 	}
 
 	d.Set("cluster_uuid", clusterUuid)
 	d.Set("instance_no", strconv.Itoa(int(ncloud.Int32Value(nodePool.InstanceNo))))
 	d.Set("node_pool_name", nodePool.Name)
-	d.Set("product_code", nodePool.ProductCode)
+	if !resuming {
+		d.Set("product_code", nodePool.ProductCode)
+	}
 	d.Set("node_count", nodePool.NodeCount)
 	d.Set("k8s_version", nodePool.K8sVersion)
 	d.Set("subnet_no", strconv.Itoa(int(ncloud.Int32Value(nodePool.SubnetNoList[0]))))
@@ -164,6 +210,14 @@ func resourceNcloudNKSNodePoolRead(ctx context.Context, d *schema.ResourceData,
 	if err := d.Set("autoscale", flattenNKSNodePoolAutoScale(nodePool.Autoscale)); err != nil {
 		log.Printf("[WARN] Error setting Autoscale set for (%s): %s", d.Id(), err)
 	}
+
+	if err := d.Set("label", flattenNKSNodePoolLabels(nodePool.Labels)); err != nil {
+		log.Printf("[WARN] Error setting label set for (%s): %s", d.Id(), err)
+	}
+
+	if err := d.Set("taint", flattenNKSNodePoolTaints(nodePool.Taints)); err != nil {
+		log.Printf("[WARN] Error setting taint set for (%s): %s", d.Id(), err)
+	}
 	return nil
 }
 
@@ -175,14 +229,24 @@ func resourceNcloudNKSNodePoolUpdate(ctx context.Context, d *schema.ResourceData
 
 	clusterUuid, nodePoolName, err := NodePoolParseResourceID(d.Id())
 	if err != nil {
-		return diag.FromErr(err)
+		return ToDiagnostics(err)
 	}
 
 	instanceNo := StringPtrOrNil(d.GetOk("instance_no"))
 
+	// The replaced node pool is created with the whole configuration, so other changes are applied together
+	if d.HasChange("product_code") && d.Get("rolling_update").(bool) {
+		if err := rollingReplaceNKSNodePool(ctx, d, config, clusterUuid, nodePoolName); err != nil {
+			// Keeps the previous product code in the state, so that the next apply resumes the replacement
+			d.Partial(true)
+			return ToDiagnostics(err)
+		}
+		return resourceNcloudNKSNodePoolRead(ctx, d, config)
+	}
+
 	if d.HasChanges("node_count", "autoscale") {
-		if err := waitForNKSNodePoolActive(ctx, d, config, clusterUuid, nodePoolName); err != nil {
-			return diag.FromErr(err)
+		if err := waitForNKSNodePoolActive(ctx, config, clusterUuid, nodePoolName, d.Timeout(schema.TimeoutUpdate)); err != nil {
+			return ToDiagnostics(err)
 		}
 		reqParams := &vnks.NodePoolUpdateBody{
 			NodeCount: Int32PtrOrNil(d.GetOk("node_count")),
@@ -195,12 +259,60 @@ func resourceNcloudNKSNodePoolUpdate(ctx context.Context, d *schema.ResourceData
 		err := config.Client.vnks.V2Api.ClustersUuidNodePoolInstanceNoPatch(ctx, reqParams, ncloud.String(clusterUuid), instanceNo)
 		if err != nil {
 			logErrorResponse("resourceNcloudNKSNodePoolUpdate", err, reqParams)
-			return diag.FromErr(err)
+			return ToDiagnostics(err)
+		}
+
+		logResponse("resourceNcloudNKSNodePoolUpdate", reqParams)
+		if err := waitForNKSNodePoolActive(ctx, config, clusterUuid, nodePoolName, d.Timeout(schema.TimeoutUpdate)); err != nil {
+			return ToDiagnostics(err)
+		}
+
+		if d.Get("wait_for_nodes_ready").(bool) {
+			if err := waitForNKSNodesReady(ctx, config, clusterUuid, nodePoolName, d.Timeout(schema.TimeoutUpdate)); err != nil {
+				return ToDiagnostics(err)
+			}
+		}
+	}
+
+	if d.HasChange("label") {
+		if err := waitForNKSNodePoolActive(ctx, config, clusterUuid, nodePoolName, d.Timeout(schema.TimeoutUpdate)); err != nil {
+			return ToDiagnostics(err)
+		}
+
+		reqParams := &NKSUpdateNodePoolLabelBody{
+			Labels: expandNKSNodePoolLabels(d.Get("label").(*schema.Set).List()),
+		}
+
+		logCommonRequest("resourceNcloudNKSNodePoolUpdate", reqParams)
+		if err := config.Client.vnksExt.ClustersUuidNodePoolInstanceNoLabelsPut(ctx, reqParams, ncloud.String(clusterUuid), instanceNo); err != nil {
+			logErrorResponse("resourceNcloudNKSNodePoolUpdate", err, reqParams)
+			return ToDiagnostics(err)
 		}
 
 		logResponse("resourceNcloudNKSNodePoolUpdate", reqParams)
-		if err := waitForNKSNodePoolActive(ctx, d, config, clusterUuid, nodePoolName); err != nil {
-			return diag.FromErr(err)
+		if err := waitForNKSNodePoolActive(ctx, config, clusterUuid, nodePoolName, d.Timeout(schema.TimeoutUpdate)); err != nil {
+			return ToDiagnostics(err)
+		}
+	}
+
+	if d.HasChange("taint") {
+		if err := waitForNKSNodePoolActive(ctx, config, clusterUuid, nodePoolName, d.Timeout(schema.TimeoutUpdate)); err != nil {
+			return ToDiagnostics(err)
+		}
+
+		reqParams := &NKSUpdateNodePoolTaintBody{
+			Taints: expandNKSNodePoolTaints(d.Get("taint").(*schema.Set).List()),
+		}
+
+		logCommonRequest("resourceNcloudNKSNodePoolUpdate", reqParams)
+		if err := config.Client.vnksExt.ClustersUuidNodePoolInstanceNoTaintsPut(ctx, reqParams, ncloud.String(clusterUuid), instanceNo); err != nil {
+			logErrorResponse("resourceNcloudNKSNodePoolUpdate", err, reqParams)
+			return ToDiagnostics(err)
+		}
+
+		logResponse("resourceNcloudNKSNodePoolUpdate", reqParams)
+		if err := waitForNKSNodePoolActive(ctx, config, clusterUuid, nodePoolName, d.Timeout(schema.TimeoutUpdate)); err != nil {
+			return ToDiagnostics(err)
 		}
 	}
 	return resourceNcloudNKSNodePoolRead(ctx, d, config)
@@ -214,106 +326,298 @@ func resourceNcloudNKSNodePoolDelete(ctx context.Context, d *schema.ResourceData
 
 	clusterUuid, nodePoolName, err := NodePoolParseResourceID(d.Id())
 	if err != nil {
-		return diag.FromErr(err)
+		return ToDiagnostics(err)
 	}
 
 	instanceNo := StringPtrOrNil(d.GetOk("instance_no"))
-	if err := waitForNKSNodePoolActive(ctx, d, config, clusterUuid, nodePoolName); err != nil {
-		return diag.FromErr(err)
+	if err := deleteNKSNodePool(ctx, config, clusterUuid, nodePoolName, instanceNo, d.Timeout(schema.TimeoutDelete)); err != nil {
+		return ToDiagnostics(err)
 	}
 
-	logCommonRequest("resourceNcloudNKSNodePoolDelete", d.Id())
-	if err := config.Client.vnks.V2Api.ClustersUuidNodePoolInstanceNoDelete(ctx, ncloud.String(clusterUuid), instanceNo); err != nil {
-		logErrorResponse("resourceNcloudNKSNodePoolDelete", err, instanceNo)
-		return diag.FromErr(err)
+	return nil
+}
+
+func resourceNcloudNKSNodePoolCustomizeDiff(_ context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+	// Without rolling update, changing the product code replaces the node pool
+	if diff.Id() != "" && diff.HasChange("product_code") && !diff.Get("rolling_update").(bool) {
+		return diff.ForceNew("product_code")
+	}
+	return nil
+}
+
+func expandNKSNodePoolCreationBody(d *schema.ResourceData, nodePoolName string) *NKSNodePoolCreationBody {
+	reqParams := &NKSNodePoolCreationBody{
+		NodePoolCreationBody: vnks.NodePoolCreationBody{
+			Name:        ncloud.String(nodePoolName),
+			NodeCount:   Int32PtrOrNil(d.GetOk("node_count")),
+			ProductCode: StringPtrOrNil(d.GetOk("product_code")),
+			SubnetNo:    getInt32FromString(d.GetOk("subnet_no")),
+		},
+	}
+
+	if _, ok := d.GetOk("autoscale"); ok {
+		reqParams.Autoscale = expandNKSNodePoolAutoScale(d.Get("autoscale").([]interface{}))
+	}
+
+	if label, ok := d.GetOk("label"); ok {
+		reqParams.Label = expandNKSNodePoolLabels(label.(*schema.Set).List())
+	}
+
+	if taint, ok := d.GetOk("taint"); ok {
+		reqParams.Taint = expandNKSNodePoolTaints(taint.(*schema.Set).List())
+	}
+
+	return reqParams
+}
+
+func createNKSNodePool(ctx context.Context, d *schema.ResourceData, config *ProviderConfig, clusterUuid string, reqParams *NKSNodePoolCreationBody, timeout time.Duration) error {
+	logCommonRequest("createNKSNodePool", reqParams)
+	if err := config.Client.vnksExt.ClustersUuidNodePoolPost(ctx, reqParams, ncloud.String(clusterUuid)); err != nil {
+		logErrorResponse("createNKSNodePool", err, reqParams)
+		return err
 	}
 
-	if err := waitForNKSNodePoolDeletion(ctx, d, config); err != nil {
-		return diag.FromErr(err)
+	logResponse("createNKSNodePool", reqParams)
+	if err := waitForNKSNodePoolActive(ctx, config, clusterUuid, ncloud.StringValue(reqParams.Name), timeout); err != nil {
+		return err
 	}
 
+	if d.Get("wait_for_nodes_ready").(bool) {
+		return waitForNKSNodesReady(ctx, config, clusterUuid, ncloud.StringValue(reqParams.Name), timeout)
+	}
 	return nil
 }
 
-func waitForNKSNodePoolDeletion(ctx context.Context, d *schema.ResourceData, config *ProviderConfig) error {
-	stateConf := &resource.StateChangeConf{
-		Pending: []string{NKSNodePoolStatusNodeScaleDown, NKSStatusDeletingCode},
-		Target:  []string{NKSStatusNullCode},
-		Refresh: func() (result interface{}, state string, err error) {
+func deleteNKSNodePool(ctx context.Context, config *ProviderConfig, clusterUuid string, nodePoolName string, instanceNo *string, timeout time.Duration) error {
+	if err := waitForNKSNodePoolActive(ctx, config, clusterUuid, nodePoolName, timeout); err != nil {
+		return err
+	}
 
-			clusterUuid, nodePoolName, err := NodePoolParseResourceID(d.Id())
-			if err != nil {
-				return nil, "", err
-			}
+	logCommonRequest("deleteNKSNodePool", nodePoolName)
+	if err := config.Client.vnks.V2Api.ClustersUuidNodePoolInstanceNoDelete(ctx, ncloud.String(clusterUuid), instanceNo); err != nil {
+		logErrorResponse("deleteNKSNodePool", err, instanceNo)
+		return err
+	}
 
-			np, err := getNKSNodePool(ctx, config, clusterUuid, nodePoolName)
-			if err != nil {
-				return nil, "", err
-			}
+	return waitForNKSNodePoolDeletion(ctx, config, clusterUuid, nodePoolName, timeout)
+}
 
-			if np == nil {
-				return nodePoolName, NKSStatusNullCode, nil
-			}
+// rollingReplaceNKSNodePool replaces nodes of the node pool without losing capacity.
+// NKS can neither change the product code of a node pool nor rename one, and node pool names are unique in a cluster,
+// so the workloads move to a surge node pool first and then back to the node pool recreated with the same name.
+// Every node is replaced twice in exchange for keeping the name, and so the id, of the node pool.
+// Steps done by a failed apply are skipped, so the next apply resumes the replacement.
+func rollingReplaceNKSNodePool(ctx context.Context, d *schema.ResourceData, config *ProviderConfig, clusterUuid string, nodePoolName string) error {
+	surgeName := nksSurgeNodePoolName(nodePoolName)
+	timeout := d.Timeout(schema.TimeoutUpdate)
+
+	surge, err := getNKSNodePool(ctx, config, clusterUuid, surgeName)
+	if err != nil {
+		return err
+	}
+
+	if surge == nil {
+		if err := createNKSNodePool(ctx, d, config, clusterUuid, expandNKSNodePoolCreationBody(d, surgeName), timeout); err != nil {
+			return err
+		}
+	}
+
+	// Workloads drain onto the other node pool only once its nodes are Ready, including one still creating when a failed apply resumes
+	if err := waitForNKSNodePoolNodesReady(ctx, config, clusterUuid, surgeName, timeout); err != nil {
+		return err
+	}
+
+	np, err := getNKSNodePool(ctx, config, clusterUuid, nodePoolName)
+	if err != nil {
+		return err
+	}
+
+	// The node pool with the new product code is the one recreated already
+	if np == nil || ncloud.StringValue(np.ProductCode) != d.Get("product_code").(string) {
+		if err := drainAndDeleteNKSNodePool(ctx, d, config, clusterUuid, nodePoolName); err != nil {
+			return err
+		}
+
+		if err := createNKSNodePool(ctx, d, config, clusterUuid, expandNKSNodePoolCreationBody(d, nodePoolName), timeout); err != nil {
+			return err
+		}
+	}
+
+	if err := waitForNKSNodePoolNodesReady(ctx, config, clusterUuid, nodePoolName, timeout); err != nil {
+		return err
+	}
+
+	return drainAndDeleteNKSNodePool(ctx, d, config, clusterUuid, surgeName)
+}
+
+// waitForNKSNodePoolNodesReady waits until the node pool is active and all of its nodes are Ready
+func waitForNKSNodePoolNodesReady(ctx context.Context, config *ProviderConfig, clusterUuid string, nodePoolName string, timeout time.Duration) error {
+	if err := waitForNKSNodePoolActive(ctx, config, clusterUuid, nodePoolName, timeout); err != nil {
+		return err
+	}
+
+	return waitForNKSNodesReady(ctx, config, clusterUuid, nodePoolName, timeout)
+}
+
+// drainAndDeleteNKSNodePool cordons and drains every node of the node pool through the Kubernetes API and deletes it
+func drainAndDeleteNKSNodePool(ctx context.Context, d *schema.ResourceData, config *ProviderConfig, clusterUuid string, nodePoolName string) error {
+	np, err := getNKSNodePool(ctx, config, clusterUuid, nodePoolName)
+	if err != nil {
+		return err
+	}
+
+	if np == nil {
+		return nil
+	}
+
+	nodes, err := getNKSNodePoolWorkerNodes(ctx, config, clusterUuid, nodePoolName)
+	if err != nil {
+		return err
+	}
+
+	kubeClient, err := getNKSKubeClient(ctx, config, clusterUuid)
+	if err != nil {
+		return err
+	}
+
+	nodeNames := make([]string, 0, len(nodes))
+	for _, node := range nodes {
+		nodeNames = append(nodeNames, ncloud.StringValue(node.Name))
+	}
+
+	if err := kubeClient.DrainNodes(ctx, nodeNames, d.Timeout(schema.TimeoutUpdate)); err != nil {
+		return fmt.Errorf("error draining NKS NodePool (%s): %s", nodePoolName, err)
+	}
+
+	instanceNo := ncloud.IntString(int(ncloud.Int32Value(np.InstanceNo)))
+	return deleteNKSNodePool(ctx, config, clusterUuid, nodePoolName, instanceNo, d.Timeout(schema.TimeoutUpdate))
+}
 
-			return np, ncloud.StringValue(np.Status), nil
+func nksSurgeNodePoolName(nodePoolName string) string {
+	if len(nodePoolName)+len(NKSNodePoolSurgeSuffix) > NKSNodePoolNameMaxLength {
+		nodePoolName = nodePoolName[:NKSNodePoolNameMaxLength-len(NKSNodePoolSurgeSuffix)]
+	}
+	return nodePoolName + NKSNodePoolSurgeSuffix
+}
 
+func waitForNKSNodePoolDeletion(ctx context.Context, config *ProviderConfig, clusterUuid string, nodePoolName string, timeout time.Duration) error {
+	waiter := &Waiter[NKSNodePoolRes]{
+		Name:    fmt.Sprintf("NKS NodePool (%s)", nodePoolName),
+		Pending: []string{NKSNodePoolStatusNodeScaleDown, NKSStatusDeletingCode},
+		Target:  []string{NKSStatusNullCode},
+		Refresh: func() (*NKSNodePoolRes, error) {
+			return getNKSNodePool(ctx, config, clusterUuid, nodePoolName)
+		},
+		Status: func(np *NKSNodePoolRes) string {
+			return ncloud.StringValue(np.Status)
 		},
-		Timeout:    d.Timeout(schema.TimeoutDelete),
-		MinTimeout: 3 * time.Second,
-		Delay:      2 * time.Second,
+		NotFoundStatus: NKSStatusNullCode,
+		Timeout:        timeout,
+		Delay:          2 * time.Second,
 	}
-	if _, err := stateConf.WaitForStateContext(ctx); err != nil {
-		return fmt.Errorf("Error waiting for NKS NodePool (%s) to become terminating: %s", d.Id(), err)
+	if _, err := waiter.Wait(ctx); err != nil {
+		return fmt.Errorf("Error waiting for NKS NodePool (%s) to become terminating: %s", nodePoolName, err)
 	}
 	return nil
 }
 
-func waitForNKSNodePoolActive(ctx context.Context, d *schema.ResourceData, config *ProviderConfig, clusterUuid string, nodePoolName string) error {
-	stateConf := &resource.StateChangeConf{
-		Pending: []string{NKSStatusCreatingCode, NKSNodePoolStatusNodeScaleOut, NKSNodePoolStatusNodeScaleDown},
+func waitForNKSNodePoolActive(ctx context.Context, config *ProviderConfig, clusterUuid string, nodePoolName string, timeout time.Duration) error {
+	waiter := &Waiter[NKSNodePoolRes]{
+		Name:    fmt.Sprintf("NKS NodePool (%s)", nodePoolName),
+		Pending: []string{NKSStatusCreatingCode, NKSNodePoolStatusNodeScaleOut, NKSNodePoolStatusNodeScaleDown, NKSNodePoolStatusUpgrade, NKSNodePoolStatusRotateScaleOut, NKSNodePoolStatusRotateScaleDown},
 		Target:  []string{NKSNodePoolStatusRunCode},
-		Refresh: func() (result interface{}, state string, err error) {
-			np, err := getNKSNodePool(ctx, config, clusterUuid, nodePoolName)
-			if err != nil {
-				return nil, "", err
-			}
-			if np == nil {
-				return np, NKSStatusNullCode, nil
-			}
-			return np, ncloud.StringValue(np.Status), nil
-
+		Refresh: func() (*NKSNodePoolRes, error) {
+			return getNKSNodePool(ctx, config, clusterUuid, nodePoolName)
 		},
-		Timeout:    d.Timeout(schema.TimeoutCreate),
-		MinTimeout: 3 * time.Second,
-		Delay:      2 * time.Second,
+		Status: func(np *NKSNodePoolRes) string {
+			return ncloud.StringValue(np.Status)
+		},
+		NotFoundStatus: NKSStatusNullCode,
+		Timeout:        timeout,
+		Delay:          2 * time.Second,
 	}
-	if _, err := stateConf.WaitForStateContext(ctx); err != nil {
+	if _, err := waiter.Wait(ctx); err != nil {
 		return fmt.Errorf("error waiting for NKS NodePool (%s) to become activating: %s", nodePoolName, err)
 	}
 	return nil
 }
 
-func getNKSNodePool(ctx context.Context, config *ProviderConfig, uuid string, nodePoolName string) (*vnks.NodePoolRes, error) {
+// waitForNKSNodesReady waits until the nodes of the node pool joined the cluster and became Ready through the Kubernetes API
+func waitForNKSNodesReady(ctx context.Context, config *ProviderConfig, clusterUuid string, nodePoolName string, timeout time.Duration) error {
+	np, err := getNKSNodePool(ctx, config, clusterUuid, nodePoolName)
+	if err != nil {
+		return err
+	}
+
+	if np == nil {
+		return fmt.Errorf("NKS NodePool (%s) not found", nodePoolName)
+	}
+
+	kubeClient, err := getNKSKubeClient(ctx, config, clusterUuid)
+	if err != nil {
+		return err
+	}
+
+	nodeNames := func() ([]string, error) {
+		nodes, err := getNKSNodePoolWorkerNodes(ctx, config, clusterUuid, nodePoolName)
+		if err != nil {
+			return nil, err
+		}
+
+		var names []string
+		for _, node := range nodes {
+			names = append(names, ncloud.StringValue(node.Name))
+		}
+		return names, nil
+	}
+
+	expected := int(ncloud.Int32Value(np.NodeCount))
+	if err := kubeClient.WaitForNodesReady(ctx, timeout, expected, nodeNames); err != nil {
+		return fmt.Errorf("error waiting for nodes of NKS NodePool (%s) to be Ready: %s", nodePoolName, err)
+	}
+	return nil
+}
+
+func getNKSNodePool(ctx context.Context, config *ProviderConfig, uuid string, nodePoolName string) (*NKSNodePoolRes, error) {
 	nps, err := getNKSNodePools(ctx, config, uuid)
 	if err != nil {
 		return nil, err
 	}
+	return findNKSNodePool(nps, nodePoolName), nil
+}
+
+func findNKSNodePool(nps []*NKSNodePoolRes, nodePoolName string) *NKSNodePoolRes {
 	for _, np := range nps {
 		if ncloud.StringValue(np.Name) == nodePoolName {
-			return np, nil
+			return np
 		}
 	}
-	return nil, nil
+	return nil
 }
 
-func getNKSNodePools(ctx context.Context, config *ProviderConfig, uuid string) ([]*vnks.NodePoolRes, error) {
-	resp, err := config.Client.vnks.V2Api.ClustersUuidNodePoolGet(ctx, ncloud.String(uuid))
+func getNKSNodePools(ctx context.Context, config *ProviderConfig, uuid string) ([]*NKSNodePoolRes, error) {
+	resp, err := config.Client.vnksExt.ClustersUuidNodePoolGet(ctx, ncloud.String(uuid))
 	if err != nil {
 		return nil, err
 	}
 	return resp.NodePool, nil
 }
 
+func getNKSNodePoolWorkerNodes(ctx context.Context, config *ProviderConfig, uuid string, nodePoolName string) ([]*vnks.WorkerNode, error) {
+	resp, err := config.Client.vnks.V2Api.ClustersUuidNodesGet(ctx, ncloud.String(uuid))
+	if err != nil {
+		return nil, err
+	}
+
+	var nodes []*vnks.WorkerNode
+	for _, node := range resp.Nodes {
+		if ncloud.StringValue(node.NodePoolName) == nodePoolName {
+			nodes = append(nodes, node)
+		}
+	}
+	return nodes, nil
+}
+
 func NodePoolCreateResourceID(clusterName, nodePoolName string) string {
 	parts := []string{clusterName, nodePoolName}
 	id := strings.Join(parts, NKSNodePoolIDSeparator)
@@ -5,7 +5,6 @@ import (
 	"errors"
 	"fmt"
 	"github.com/NaverCloudPlatform/ncloud-sdk-go-v2/ncloud"
-	"github.com/NaverCloudPlatform/ncloud-sdk-go-v2/services/vnks"
 	"regexp"
 	"testing"
 
@@ -14,7 +13,7 @@ import (
 )
 
 func TestAccResourceNcloudNKSNodePool_basic(t *testing.T) {
-	var nodePool vnks.NodePoolRes
+	var nodePool NKSNodePoolRes
 	clusterName := getTestClusterName()
 	resourceName := "ncloud_nks_node_pool.node_pool"
 	k8sVersion := "1.21"
@@ -35,16 +34,17 @@ func TestAccResourceNcloudNKSNodePool_basic(t *testing.T) {
 				),
 			},
 			{
-				ResourceName:      resourceName,
-				ImportState:       true,
-				ImportStateVerify: true,
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"rolling_update", "wait_for_nodes_ready"},
 			},
 		},
 	})
 }
 
 func TestAccResourceNcloudNKSNodePool_publicNetwork(t *testing.T) {
-	var nodePool vnks.NodePoolRes
+	var nodePool NKSNodePoolRes
 	clusterName := getTestClusterName()
 	resourceName := "ncloud_nks_node_pool.node_pool"
 	k8sVersion := "1.21"
@@ -65,16 +65,17 @@ func TestAccResourceNcloudNKSNodePool_publicNetwork(t *testing.T) {
 				),
 			},
 			{
-				ResourceName:      resourceName,
-				ImportState:       true,
-				ImportStateVerify: true,
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"rolling_update", "wait_for_nodes_ready"},
 			},
 		},
 	})
 }
 
 func TestAccResourceNcloudNKSNodePool_updateNodeCountAndAutoScale(t *testing.T) {
-	var nodePool vnks.NodePoolRes
+	var nodePool NKSNodePoolRes
 	clusterName := getTestClusterName()
 	region, clusterType, productCode := getRegionAndNKSType()
 	resourceName := "ncloud_nks_node_pool.node_pool"
@@ -104,9 +105,83 @@ func TestAccResourceNcloudNKSNodePool_updateNodeCountAndAutoScale(t *testing.T)
 				),
 			},
 			{
-				ResourceName:      resourceName,
-				ImportState:       true,
-				ImportStateVerify: true,
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"rolling_update", "wait_for_nodes_ready"},
+			},
+		},
+	})
+}
+
+func TestAccResourceNcloudNKSNodePool_updateLabelAndTaint(t *testing.T) {
+	var nodePool NKSNodePoolRes
+	clusterName := getTestClusterName()
+	region, clusterType, productCode := getRegionAndNKSType()
+	resourceName := "ncloud_nks_node_pool.node_pool"
+	k8sVersion := "1.21"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckNKSNodePoolDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccResourceNcloudNKSNodePoolLabelAndTaintConfig(clusterName, clusterType, productCode, TF_TEST_NKS_LOGIN_KEY, k8sVersion, region, "gpu", "NoSchedule"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckNKSNodePoolExists(resourceName, &nodePool),
+					resource.TestCheckResourceAttr(resourceName, "label.#", "1"),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "label.*", map[string]string{"key": "role", "value": "gpu"}),
+					resource.TestCheckResourceAttr(resourceName, "taint.#", "1"),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "taint.*", map[string]string{"key": "dedicated", "value": "gpu", "effect": "NoSchedule"}),
+				),
+			},
+			{
+				Config: testAccResourceNcloudNKSNodePoolLabelAndTaintConfig(clusterName, clusterType, productCode, TF_TEST_NKS_LOGIN_KEY, k8sVersion, region, "batch", "NoExecute"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckNKSNodePoolExists(resourceName, &nodePool),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "label.*", map[string]string{"key": "role", "value": "batch"}),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "taint.*", map[string]string{"key": "dedicated", "value": "batch", "effect": "NoExecute"}),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"rolling_update", "wait_for_nodes_ready"},
+			},
+		},
+	})
+}
+
+func TestAccResourceNcloudNKSNodePool_rollingUpdateProductCode(t *testing.T) {
+	var before, after NKSNodePoolRes
+	clusterName := getTestClusterName()
+	region, clusterType, productCode := getRegionAndNKSType()
+	resourceName := "ncloud_nks_node_pool.node_pool"
+	k8sVersion := "1.21"
+	newProductCode := "SVR.VSVR.STAND.C004.M016.NET.SSD.B050.G002"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckNKSNodePoolDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccResourceNcloudNKSNodePoolRollingUpdateConfig(clusterName, clusterType, productCode, TF_TEST_NKS_LOGIN_KEY, k8sVersion, region),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckNKSNodePoolExists(resourceName, &before),
+					resource.TestCheckResourceAttr(resourceName, "product_code", productCode),
+				),
+			},
+			{
+				Config: testAccResourceNcloudNKSNodePoolRollingUpdateConfig(clusterName, clusterType, newProductCode, TF_TEST_NKS_LOGIN_KEY, k8sVersion, region),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckNKSNodePoolExists(resourceName, &after),
+					resource.TestCheckResourceAttr(resourceName, "product_code", newProductCode),
+					resource.TestCheckResourceAttr(resourceName, "node_pool_name", clusterName),
+					testAccCheckNKSSurgeNodePoolDestroy(resourceName),
+				),
 			},
 		},
 	})
@@ -322,7 +397,162 @@ resource "ncloud_nks_node_pool" "node_pool" {
 `, name, clusterType, productCode, nodeCount, loginKey, version, region)
 }
 
-func testAccCheckNKSNodePoolExists(n string, nodePool *vnks.NodePoolRes) resource.TestCheckFunc {
+func testAccResourceNcloudNKSNodePoolLabelAndTaintConfig(name string, clusterType string, productCode string, loginKey string, version string, region string, role string, effect string) string {
+	return fmt.Sprintf(`
+resource "ncloud_vpc" "vpc" {
+	name               = "%[1]s"
+	ipv4_cidr_block    = "10.2.0.0/16"
+}
+
+resource "ncloud_subnet" "subnet" {
+	vpc_no             = ncloud_vpc.vpc.vpc_no
+	name               = "%[1]s"
+	subnet             = "10.2.1.0/24"
+	zone               = "%[6]s-1"
+	network_acl_no     = ncloud_vpc.vpc.default_network_acl_no
+	subnet_type        = "PRIVATE"
+	usage_type         = "GEN"
+}
+
+resource "ncloud_subnet" "subnet_lb" {
+	vpc_no             = ncloud_vpc.vpc.vpc_no
+	name               = "%[1]s-lb"
+	subnet             = "10.2.100.0/24"
+	zone               = "%[6]s-1"
+	network_acl_no     = ncloud_vpc.vpc.default_network_acl_no
+	subnet_type        = "PRIVATE"
+	usage_type         = "LOADB"
+}
+
+data "ncloud_nks_versions" "version" {
+  filter {
+    name = "value"
+    values = ["%[5]s"]
+    regex = true
+  }
+}
+
+resource "ncloud_nks_cluster" "cluster" {
+  name                        = "%[1]s"
+  cluster_type                = "%[2]s"
+  k8s_version                 = data.ncloud_nks_versions.version.versions.0.value
+  login_key_name              = "%[4]s"
+  lb_private_subnet_no        = ncloud_subnet.subnet_lb.id
+  subnet_no_list              = [
+    ncloud_subnet.subnet.id,
+  ]
+  vpc_no                      = ncloud_vpc.vpc.vpc_no
+  zone                        = "%[6]s-1"
+}
+
+resource "ncloud_nks_node_pool" "node_pool" {
+  cluster_uuid   = ncloud_nks_cluster.cluster.uuid
+  node_pool_name = "%[1]s"
+  node_count     = 1
+  product_code   = "%[3]s"
+  subnet_no      = ncloud_subnet.subnet.id
+
+  label {
+    key   = "role"
+    value = "%[7]s"
+  }
+
+  taint {
+    key    = "dedicated"
+    value  = "%[7]s"
+    effect = "%[8]s"
+  }
+}
+`, name, clusterType, productCode, loginKey, version, region, role, effect)
+}
+
+func testAccResourceNcloudNKSNodePoolRollingUpdateConfig(name string, clusterType string, productCode string, loginKey string, version string, region string) string {
+	return fmt.Sprintf(`
+resource "ncloud_vpc" "vpc" {
+	name               = "%[1]s"
+	ipv4_cidr_block    = "10.2.0.0/16"
+}
+
+resource "ncloud_subnet" "subnet" {
+	vpc_no             = ncloud_vpc.vpc.vpc_no
+	name               = "%[1]s"
+	subnet             = "10.2.1.0/24"
+	zone               = "%[6]s-1"
+	network_acl_no     = ncloud_vpc.vpc.default_network_acl_no
+	subnet_type        = "PRIVATE"
+	usage_type         = "GEN"
+}
+
+resource "ncloud_subnet" "subnet_lb" {
+	vpc_no             = ncloud_vpc.vpc.vpc_no
+	name               = "%[1]s-lb"
+	subnet             = "10.2.100.0/24"
+	zone               = "%[6]s-1"
+	network_acl_no     = ncloud_vpc.vpc.default_network_acl_no
+	subnet_type        = "PRIVATE"
+	usage_type         = "LOADB"
+}
+
+data "ncloud_nks_versions" "version" {
+  filter {
+    name = "value"
+    values = ["%[5]s"]
+    regex = true
+  }
+}
+
+resource "ncloud_nks_cluster" "cluster" {
+  name                        = "%[1]s"
+  cluster_type                = "%[2]s"
+  k8s_version                 = data.ncloud_nks_versions.version.versions.0.value
+  login_key_name              = "%[4]s"
+  lb_private_subnet_no        = ncloud_subnet.subnet_lb.id
+  subnet_no_list              = [
+    ncloud_subnet.subnet.id,
+  ]
+  vpc_no                      = ncloud_vpc.vpc.vpc_no
+  zone                        = "%[6]s-1"
+}
+
+resource "ncloud_nks_node_pool" "node_pool" {
+  cluster_uuid   = ncloud_nks_cluster.cluster.uuid
+  node_pool_name = "%[1]s"
+  node_count     = 1
+  product_code   = "%[3]s"
+  subnet_no      = ncloud_subnet.subnet.id
+  rolling_update = true
+
+  wait_for_nodes_ready = true
+}
+`, name, clusterType, productCode, loginKey, version, region)
+}
+
+func testAccCheckNKSSurgeNodePoolDestroy(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		clusterUuid, nodePoolName, err := NodePoolParseResourceID(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		config := testAccProvider.Meta().(*ProviderConfig)
+		np, err := getNKSNodePool(context.Background(), config, clusterUuid, nksSurgeNodePoolName(nodePoolName))
+		if err != nil {
+			return err
+		}
+
+		if np != nil {
+			return errors.New("Surge NodePool still exists")
+		}
+		return nil
+	}
+}
+
+func testAccCheckNKSNodePoolExists(n string, nodePool *NKSNodePoolRes) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		rs, ok := s.RootModule().Resources[n]
 		if !ok {
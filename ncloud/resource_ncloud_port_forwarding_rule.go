@@ -1,6 +1,7 @@
 package ncloud
 
 import (
+	"context"
 	"fmt"
 	"log"
 	"strconv"
@@ -9,6 +10,7 @@ import (
 
 	"github.com/NaverCloudPlatform/ncloud-sdk-go-v2/ncloud"
 	"github.com/NaverCloudPlatform/ncloud-sdk-go-v2/services/server"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
@@ -20,11 +22,14 @@ func init() {
 
 func resourceNcloudPortForwadingRule() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceNcloudPortForwardingRuleCreate,
-		Read:   resourceNcloudPortForwardingRuleRead,
-		Update: resourceNcloudPortForwardingRuleUpdate,
-		Delete: resourceNcloudPortForwardingRuleDelete,
-		Exists: resourceNcloudPortForwardingRuleExists,
+		CreateContext: resourceNcloudPortForwardingRuleCreate,
+		ReadContext:   resourceNcloudPortForwardingRuleRead,
+		UpdateContext: resourceNcloudPortForwardingRuleUpdate,
+		DeleteContext: resourceNcloudPortForwardingRuleDelete,
+		Exists:        resourceNcloudPortForwardingRuleExists,
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceNcloudPortForwardingRuleImportState,
+		},
 
 		Timeouts: &schema.ResourceTimeout{
 			Create: schema.DefaultTimeout(DefaultCreateTimeout),
@@ -73,12 +78,12 @@ func resourceNcloudPortForwadingRule() *schema.Resource {
 	}
 }
 
-func resourceNcloudPortForwardingRuleCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceNcloudPortForwardingRuleCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	config := meta.(*ProviderConfig)
 
 	portForwardingConfigurationNo, err := getPortForwardingConfigurationNo(d, meta)
 	if err != nil {
-		return err
+		return ToDiagnostics(err)
 	}
 
 	var portForwardingExternalPort int32
@@ -107,16 +112,14 @@ func resourceNcloudPortForwardingRuleCreate(d *schema.ResourceData, meta interfa
 	}
 
 	var resp *server.AddPortForwardingRulesResponse
-	err = resource.Retry(d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
+	err = resource.RetryContext(ctx, d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
 		var err error
 		logCommonRequest("AddPortForwardingRules", reqParams)
 		resp, err = config.Client.server.V2Api.AddPortForwardingRules(reqParams)
 		if err != nil {
-			errBody, _ := GetCommonErrorBody(err)
-			if containsInStringList(errBody.ReturnCode, []string{ApiErrorUnknown, ApiErrorPortForwardingObjectInOperation}) {
+			if containsInStringList(apiErrorCode(err), []string{ApiErrorUnknown, ApiErrorPortForwardingObjectInOperation}) {
 				logErrorResponse("retry AddPortForwardingRules", err, reqParams)
-				time.Sleep(time.Second * 5)
-				return resource.RetryableError(err)
+				return retryableErrorAfter(ctx, time.Second*5, err)
 			}
 			return resource.NonRetryableError(err)
 		}
@@ -126,19 +129,19 @@ func resourceNcloudPortForwardingRuleCreate(d *schema.ResourceData, meta interfa
 
 	if err != nil {
 		logErrorResponse("AddPortForwardingRules", err, reqParams)
-		return err
+		return ToDiagnostics(err)
 	}
 	d.SetId(newPortForwardingRuleId)
-	return resourceNcloudPortForwardingRuleRead(d, meta)
+	return resourceNcloudPortForwardingRuleRead(ctx, d, meta)
 }
 
-func resourceNcloudPortForwardingRuleRead(d *schema.ResourceData, meta interface{}) error {
+func resourceNcloudPortForwardingRuleRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	client := meta.(*ProviderConfig).Client
 
 	_, zoneNo, portForwardingExternalPort := parsePortForwardingRuleId(d.Id())
 	resp, err := getPortForwardingRuleList(client, zoneNo)
 	if err != nil {
-		return err
+		return ToDiagnostics(err)
 	}
 
 	var portForwardingRule *server.PortForwardingRule
@@ -166,6 +169,42 @@ func resourceNcloudPortForwardingRuleRead(d *schema.ResourceData, meta interface
 	return nil
 }
 
+// resourceNcloudPortForwardingRuleImportState imports the port forwarding rule of the server by the server instance number
+func resourceNcloudPortForwardingRuleImportState(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	config := meta.(*ProviderConfig)
+	if config.SupportVPC {
+		return nil, NotSupportVpc("resource `ncloud_port_forwarding_rule`")
+	}
+
+	serverInstanceNo := d.Id()
+	zoneNo, err := getServerZoneNo(config, serverInstanceNo)
+	if err != nil {
+		return nil, err
+	}
+	if zoneNo == "" {
+		return nil, fmt.Errorf("no matching server instance: %s", serverInstanceNo)
+	}
+
+	resp, err := getPortForwardingRuleList(config.Client, zoneNo)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, rule := range resp.PortForwardingRuleList {
+		if rule.ServerInstance == nil || ncloud.StringValue(rule.ServerInstance.ServerInstanceNo) != serverInstanceNo {
+			continue
+		}
+
+		d.Set("server_instance_no", serverInstanceNo)
+		d.Set("port_forwarding_external_port", rule.PortForwardingExternalPort)
+		d.Set("port_forwarding_configuration_no", rule.PortForwardingConfigurationNo)
+		d.SetId(PortForwardingRuleId(ncloud.StringValue(rule.PortForwardingConfigurationNo), zoneNo, ncloud.Int32Value(rule.PortForwardingExternalPort)))
+		return []*schema.ResourceData{d}, nil
+	}
+
+	return nil, fmt.Errorf("no port forwarding rule for the server instance: %s", serverInstanceNo)
+}
+
 func resourceNcloudPortForwardingRuleExists(d *schema.ResourceData, meta interface{}) (bool, error) {
 	config := meta.(*ProviderConfig)
 
@@ -180,16 +219,16 @@ func resourceNcloudPortForwardingRuleExists(d *schema.ResourceData, meta interfa
 	return hasPortForwardingRule(config.Client, zoneNo, portForwardingExternalPort)
 }
 
-func resourceNcloudPortForwardingRuleUpdate(d *schema.ResourceData, meta interface{}) error {
-	return resourceNcloudPortForwardingRuleRead(d, meta)
+func resourceNcloudPortForwardingRuleUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	return resourceNcloudPortForwardingRuleRead(ctx, d, meta)
 }
 
-func resourceNcloudPortForwardingRuleDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceNcloudPortForwardingRuleDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	client := meta.(*ProviderConfig).Client
 
 	portForwardingConfigurationNo, err := getPortForwardingConfigurationNo(d, meta)
 	if err != nil {
-		return err
+		return ToDiagnostics(err)
 	}
 	var portForwardingExternalPort int32
 	if v, ok := d.GetOk("port_forwarding_external_port"); ok {
@@ -213,16 +252,14 @@ func resourceNcloudPortForwardingRuleDelete(d *schema.ResourceData, meta interfa
 	}
 
 	var resp *server.DeletePortForwardingRulesResponse
-	err = resource.Retry(d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
+	err = resource.RetryContext(ctx, d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
 		var err error
 		logCommonRequest("DeletePortForwardingRules", reqParams)
 		resp, err = client.server.V2Api.DeletePortForwardingRules(reqParams)
 		if err != nil {
-			errBody, _ := GetCommonErrorBody(err)
-			if containsInStringList(errBody.ReturnCode, []string{ApiErrorUnknown, ApiErrorPortForwardingObjectInOperation}) {
+			if containsInStringList(apiErrorCode(err), []string{ApiErrorUnknown, ApiErrorPortForwardingObjectInOperation}) {
 				logErrorResponse("retry DeletePortForwardingRules", err, reqParams)
-				time.Sleep(time.Second * 5)
-				return resource.RetryableError(err)
+				return retryableErrorAfter(ctx, time.Second*5, err)
 			}
 			return resource.NonRetryableError(err)
 		}
@@ -232,7 +269,7 @@ func resourceNcloudPortForwardingRuleDelete(d *schema.ResourceData, meta interfa
 
 	if err != nil {
 		logErrorResponse("DeletePortForwardingRules", err, reqParams)
-		return err
+		return ToDiagnostics(err)
 	}
 	d.SetId("")
 	return nil
@@ -268,8 +305,13 @@ func getPortForwardingConfigurationNo(d *schema.ResourceData, meta interface{})
 }
 
 func getPortForwardingConfigurationList(d *schema.ResourceData, config *ProviderConfig) (*server.GetPortForwardingConfigurationListResponse, error) {
+	regionNo, err := config.regionNo()
+	if err != nil {
+		return nil, err
+	}
+
 	reqParams := &server.GetPortForwardingConfigurationListRequest{
-		RegionNo:             ncloud.String(config.RegionNo),
+		RegionNo:             regionNo,
 		ServerInstanceNoList: []*string{ncloud.String(d.Get("server_instance_no").(string))},
 	}
 	logCommonRequest("GetPortForwardingConfigurationList", reqParams)
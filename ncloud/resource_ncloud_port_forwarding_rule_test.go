@@ -40,10 +40,27 @@ func TestAccResourceNcloudPortForwardingRuleBasic(t *testing.T) {
 						"22"),
 				),
 			},
+			{
+				ResourceName:      "ncloud_port_forwarding_rule.test",
+				ImportState:       true,
+				ImportStateIdFunc: testAccNcloudPortForwardingRuleImportStateIDFunc("ncloud_port_forwarding_rule.test"),
+				ImportStateVerify: true,
+			},
 		},
 	})
 }
 
+func testAccNcloudPortForwardingRuleImportStateIDFunc(resourceName string) resource.ImportStateIdFunc {
+	return func(s *terraform.State) (string, error) {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return "", fmt.Errorf("not found: %s", resourceName)
+		}
+
+		return rs.Primary.Attributes["server_instance_no"], nil
+	}
+}
+
 func generateExternalPort(min, max int32) int32 {
 	rand.Seed(time.Now().Unix())
 	return rand.Int31n(max-min) + min
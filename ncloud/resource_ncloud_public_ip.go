@@ -9,6 +9,7 @@ import (
 	"github.com/NaverCloudPlatform/ncloud-sdk-go-v2/ncloud"
 	"github.com/NaverCloudPlatform/ncloud-sdk-go-v2/services/server"
 	"github.com/NaverCloudPlatform/ncloud-sdk-go-v2/services/vserver"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
@@ -19,16 +20,18 @@ func init() {
 }
 
 func resourceNcloudPublicIpInstance() *schema.Resource {
-	return &schema.Resource{
-		Create: resourceNcloudPublicIpCreate,
-		Read:   resourceNcloudPublicIpRead,
-		Update: resourceNcloudPublicIpUpdate,
-		Delete: resourceNcloudPublicIpDelete,
+	r := &schema.Resource{
+		CreateContext: resourceNcloudPublicIpCreate,
+		ReadContext:   resourceNcloudPublicIpRead,
+		UpdateContext: resourceNcloudPublicIpUpdate,
+		DeleteContext: resourceNcloudPublicIpDelete,
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
 		},
 		CustomizeDiff: resourceNcloudPublicIpCustomizeDiff,
+		SchemaVersion: 1,
 		Schema: map[string]*schema.Schema{
+			"platform": platformSchema(),
 			"server_instance_no": {
 				Type:     schema.TypeString,
 				Optional: true,
@@ -50,8 +53,8 @@ func resourceNcloudPublicIpInstance() *schema.Resource {
 				Type:             schema.TypeString,
 				Optional:         true,
 				Computed:         true,
-				ForceNew:         true,
 				ValidateDiagFunc: ToDiagFunc(validation.StringInSlice([]string{"PUBLC", "GLBL"}, false)),
+				DiffSuppressFunc: suppressDeprecatedDiff,
 				Deprecated:       "This parameter is no longer used.",
 			},
 			"zone": {
@@ -74,42 +77,50 @@ func resourceNcloudPublicIpInstance() *schema.Resource {
 			},
 		},
 	}
+	r.StateUpgraders = []schema.StateUpgrader{
+		resourceNcloudPublicIpInstanceStateUpgradeV0(r),
+	}
+
+	return r
 }
 
-func resourceNcloudPublicIpCreate(d *schema.ResourceData, meta interface{}) error {
-	config := meta.(*ProviderConfig)
-	var publicIpInstanceNo *string
-	var err error
+// resourceNcloudPublicIpInstanceStateUpgradeV0 clears the deprecated `internet_line_type`, which the API no longer uses for the public IP
+func resourceNcloudPublicIpInstanceStateUpgradeV0(r *schema.Resource) schema.StateUpgrader {
+	return stateUpgrader(0, r, clearStateAttributes("internet_line_type"))
+}
 
-	if config.SupportVPC {
-		publicIpInstanceNo, err = createVpcPublicIp(d, config)
-	} else {
-		publicIpInstanceNo, err = createClassicPublicIp(d, config)
+func resourceNcloudPublicIpCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config, err := resourcePlatformConfig(d, meta)
+	if err != nil {
+		return ToDiagnostics(err)
 	}
 
+	publicIpInstanceNo, err := config.PublicIpService.Create(d)
 	if err != nil {
-		return err
+		return ToDiagnostics(err)
 	}
 
 	d.SetId(ncloud.StringValue(publicIpInstanceNo))
 	log.Printf("[INFO] Public IP ID: %s", d.Id())
 
 	if v, ok := d.GetOk("server_instance_no"); ok && v != "" {
-		if err := waitForPublicIpAssociation(config, d.Id()); err != nil {
-			return err
+		if err := waitForPublicIpAssociation(ctx, config, d.Id()); err != nil {
+			return ToDiagnostics(err)
 		}
 	}
 
-	return resourceNcloudPublicIpRead(d, meta)
+	return resourceNcloudPublicIpRead(ctx, d, meta)
 }
 
-func resourceNcloudPublicIpRead(d *schema.ResourceData, meta interface{}) error {
-	config := meta.(*ProviderConfig)
-
-	resource, err := getPublicIp(config, d.Id())
+func resourceNcloudPublicIpRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config, err := resourcePlatformConfig(d, meta)
+	if err != nil {
+		return ToDiagnostics(err)
+	}
 
+	resource, err := config.readPublicIp(d.Id())
 	if err != nil {
-		return err
+		return ToDiagnostics(err)
 	}
 
 	if resource == nil {
@@ -120,74 +131,71 @@ func resourceNcloudPublicIpRead(d *schema.ResourceData, meta interface{}) error
 	instance := ConvertToMap(resource)
 	SetSingularResourceDataFromMapSchema(resourceNcloudPublicIpInstance(), d, instance)
 	if err := d.Set("public_ip_no", resource.PublicIpInstanceNo); err != nil {
-		return err
+		return ToDiagnostics(err)
 	}
 
 	if err := d.Set("server_instance_no", resource.ServerInstanceNo); err != nil {
-		return err
+		return ToDiagnostics(err)
 	}
 
 	return nil
 }
 
-func resourceNcloudPublicIpDelete(d *schema.ResourceData, meta interface{}) error {
-	config := meta.(*ProviderConfig)
-	var err error
+func resourceNcloudPublicIpDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config, err := resourcePlatformConfig(d, meta)
+	if err != nil {
+		return ToDiagnostics(err)
+	}
 
 	// Check associated public ip
 	if associated, err := checkAssociatedPublicIP(config, d.Id()); associated {
 		// if associated public ip, disassociated the public ip
-		if err := disassociatedPublicIp(config, d.Id()); err != nil {
-			return err
+		if err := disassociatedPublicIp(ctx, config, d.Id()); err != nil {
+			return ToDiagnostics(err)
 		}
 	} else if err != nil {
-		return err
+		return ToDiagnostics(err)
 	}
 
-	if config.SupportVPC {
-		err = deleteVpcPublicIp(d, config)
-	} else {
-		err = deleteClassicPublicIp(d, config)
-	}
-
-	if err != nil {
-		return err
+	if err := config.PublicIpService.Delete(d.Id()); err != nil {
+		return ToDiagnostics(err)
 	}
 
 	d.SetId("")
 	return nil
 }
 
-func resourceNcloudPublicIpUpdate(d *schema.ResourceData, meta interface{}) error {
-	config := meta.(*ProviderConfig)
+func resourceNcloudPublicIpUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config, err := resourcePlatformConfig(d, meta)
+	if err != nil {
+		return ToDiagnostics(err)
+	}
 
 	if d.HasChange("server_instance_no") {
 		o, n := d.GetChange("server_instance_no")
 		if len(o.(string)) > 0 {
-			if err := disassociatedPublicIp(config, d.Id()); err != nil {
-				return err
+			if err := disassociatedPublicIp(ctx, config, d.Id()); err != nil {
+				return ToDiagnostics(err)
 			}
 		}
 
 		if len(n.(string)) > 0 {
-			if err := resource.Retry(time.Minute, func() *resource.RetryError {
-				if err := associatedPublicIp(d, config); err != nil {
-					errBody, _ := GetCommonErrorBody(err)
-					if errBody.ReturnCode == "1003016" {
-						time.Sleep(time.Second * 1)
-						return resource.RetryableError(err)
+			if err := resource.RetryContext(ctx, time.Minute, func() *resource.RetryError {
+				if err := associatedPublicIp(ctx, config, d.Id(), n.(string)); err != nil {
+					if apiErrorCode(err) == "1003016" {
+						return retryableErrorAfter(ctx, time.Second*1, err)
 					}
 					return resource.NonRetryableError(err)
 				}
 				return nil
 			}); err != nil {
-				return err
+				return ToDiagnostics(err)
 			}
 
 		}
 	}
 
-	return resourceNcloudPublicIpRead(d, meta)
+	return resourceNcloudPublicIpRead(ctx, d, meta)
 }
 
 func createClassicPublicIp(d *schema.ResourceData, config *ProviderConfig) (*string, error) {
@@ -198,8 +206,13 @@ func createClassicPublicIp(d *schema.ResourceData, config *ProviderConfig) (*str
 		return nil, err
 	}
 
+	regionNo, err := config.regionNo()
+	if err != nil {
+		return nil, err
+	}
+
 	reqParams := &server.CreatePublicIpInstanceRequest{
-		RegionNo:            &config.RegionNo,
+		RegionNo:            regionNo,
 		ZoneNo:              zoneNo,
 		ServerInstanceNo:    StringPtrOrNil(d.GetOk("server_instance_no")),
 		PublicIpDescription: StringPtrOrNil(d.GetOk("description")),
@@ -242,11 +255,11 @@ func createVpcPublicIp(d *schema.ResourceData, config *ProviderConfig) (*string,
 	return publicIPInstance.PublicIpInstanceNo, nil
 }
 
-func deleteClassicPublicIp(d *schema.ResourceData, config *ProviderConfig) error {
+func deleteClassicPublicIp(config *ProviderConfig, id string) error {
 	client := config.Client
 
 	reqParams := &server.DeletePublicIpInstancesRequest{
-		PublicIpInstanceNoList: []*string{ncloud.String(d.Id())},
+		PublicIpInstanceNoList: []*string{ncloud.String(id)},
 	}
 
 	logCommonRequest("deleteClassicPublicIp", reqParams)
@@ -261,12 +274,12 @@ func deleteClassicPublicIp(d *schema.ResourceData, config *ProviderConfig) error
 	return nil
 }
 
-func deleteVpcPublicIp(d *schema.ResourceData, config *ProviderConfig) error {
+func deleteVpcPublicIp(config *ProviderConfig, id string) error {
 	client := config.Client
 
 	reqParams := &vserver.DeletePublicIpInstanceRequest{
 		RegionCode:         &config.RegionCode,
-		PublicIpInstanceNo: ncloud.String(d.Id()),
+		PublicIpInstanceNo: ncloud.String(id),
 	}
 
 	logCommonRequest("deleteVpcPublicIp", reqParams)
@@ -281,113 +294,124 @@ func deleteVpcPublicIp(d *schema.ResourceData, config *ProviderConfig) error {
 	return nil
 }
 
-func getPublicIp(config *ProviderConfig, id string) (*PublicIpInstance, error) {
-	var r *PublicIpInstance
-	var err error
-	if config.SupportVPC {
-		r, err = getVpcPublicIp(config, id)
-	} else {
-		r, err = getClassicPublicIp(config, id)
+func getClassicPublicIp(config *ProviderConfig, id string) (*PublicIpInstance, error) {
+	instances, err := getClassicPublicIpInstanceList(config, []string{id})
+	if err != nil {
+		return nil, err
 	}
 
-	if err != nil {
+	if len(instances) == 0 {
+		return nil, nil
+	}
+
+	if err := validateOneResult(len(instances)); err != nil {
 		return nil, err
 	}
 
-	return r, nil
+	return instances[0], nil
 }
 
-func getClassicPublicIp(config *ProviderConfig, id string) (*PublicIpInstance, error) {
+// getClassicPublicIpInstanceList returns the public IPs found of the instance numbers
+func getClassicPublicIpInstanceList(config *ProviderConfig, ids []string) ([]*PublicIpInstance, error) {
 	client := config.Client
-	regionNo := config.RegionNo
+	regionNo, err := config.regionNo()
+	if err != nil {
+		return nil, err
+	}
 
 	reqParams := &server.GetPublicIpInstanceListRequest{
-		RegionNo:               &regionNo,
-		PublicIpInstanceNoList: []*string{ncloud.String(id)},
+		RegionNo:               regionNo,
+		PublicIpInstanceNoList: ncloud.StringList(ids),
 	}
 
-	logCommonRequest("getClassicPublicIp", reqParams)
+	logCommonRequest("getClassicPublicIpInstanceList", reqParams)
 	resp, err := client.server.V2Api.GetPublicIpInstanceList(reqParams)
 
 	if err != nil {
-		logErrorResponse("getClassicPublicIp", err, reqParams)
+		logErrorResponse("getClassicPublicIpInstanceList", err, reqParams)
 		return nil, err
 	}
-	logResponse("getClassicPublicIp", resp)
+	logResponse("getClassicPublicIpInstanceList", resp)
+
+	instances := make([]*PublicIpInstance, 0, len(resp.PublicIpInstanceList))
+	for _, r := range resp.PublicIpInstanceList {
+		p := &PublicIpInstance{
+			PublicIpInstanceNo:            r.PublicIpInstanceNo,
+			PublicIp:                      r.PublicIp,
+			PublicIpDescription:           r.PublicIpDescription,
+			PublicIpKindTypeCode:          r.PublicIpKindType.Code,
+			ZoneCode:                      r.Zone.ZoneCode,
+			PublicIpInstanceStatusCode:    r.PublicIpInstanceStatus.Code,
+			PublicIpInstanceOperationCode: r.PublicIpInstanceOperation.Code,
+		}
 
-	if len(resp.PublicIpInstanceList) == 0 {
-		return nil, nil
+		if r.ServerInstanceAssociatedWithPublicIp != nil {
+			p.ServerInstanceNo = r.ServerInstanceAssociatedWithPublicIp.ServerInstanceNo
+			p.PrivateIp = r.ServerInstanceAssociatedWithPublicIp.PrivateIp
+		}
+
+		instances = append(instances, p)
 	}
 
-	if err := validateOneResult(len(resp.PublicIpInstanceList)); err != nil {
+	return instances, nil
+}
+
+func getVpcPublicIp(config *ProviderConfig, id string) (*PublicIpInstance, error) {
+	instances, err := getVpcPublicIpInstanceList(config, []string{id})
+	if err != nil {
 		return nil, err
 	}
 
-	r := resp.PublicIpInstanceList[0]
-
-	p := &PublicIpInstance{
-		PublicIpInstanceNo:            r.PublicIpInstanceNo,
-		PublicIp:                      r.PublicIp,
-		PublicIpDescription:           r.PublicIpDescription,
-		PublicIpKindTypeCode:          r.PublicIpKindType.Code,
-		ZoneCode:                      r.Zone.ZoneCode,
-		PublicIpInstanceStatusCode:    r.PublicIpInstanceStatus.Code,
-		PublicIpInstanceOperationCode: r.PublicIpInstanceOperation.Code,
+	if len(instances) == 0 {
+		return nil, nil
 	}
 
-	if r.ServerInstanceAssociatedWithPublicIp != nil {
-		p.ServerInstanceNo = r.ServerInstanceAssociatedWithPublicIp.ServerInstanceNo
-		p.PrivateIp = r.ServerInstanceAssociatedWithPublicIp.PrivateIp
+	if err := validateOneResult(len(instances)); err != nil {
+		return nil, err
 	}
 
-	return p, nil
+	return instances[0], nil
 }
 
-func getVpcPublicIp(config *ProviderConfig, id string) (*PublicIpInstance, error) {
+// getVpcPublicIpInstanceList returns the public IPs found of the instance numbers
+func getVpcPublicIpInstanceList(config *ProviderConfig, ids []string) ([]*PublicIpInstance, error) {
 	client := config.Client
 	regionCode := config.RegionCode
 
 	reqParams := &vserver.GetPublicIpInstanceListRequest{
 		RegionCode:             &regionCode,
-		PublicIpInstanceNoList: []*string{ncloud.String(id)},
+		PublicIpInstanceNoList: ncloud.StringList(ids),
 	}
 
-	logCommonRequest("getVpcPublicIp", reqParams)
+	logCommonRequest("getVpcPublicIpInstanceList", reqParams)
 	resp, err := client.vserver.V2Api.GetPublicIpInstanceList(reqParams)
 
 	if err != nil {
-		logErrorResponse("getVpcPublicIp", err, reqParams)
+		logErrorResponse("getVpcPublicIpInstanceList", err, reqParams)
 		return nil, err
 	}
-	logResponse("getVpcPublicIp", resp)
+	logResponse("getVpcPublicIpInstanceList", resp)
 
-	if len(resp.PublicIpInstanceList) == 0 {
-		return nil, nil
+	instances := make([]*PublicIpInstance, 0, len(resp.PublicIpInstanceList))
+	for _, r := range resp.PublicIpInstanceList {
+		instances = append(instances, &PublicIpInstance{
+			PublicIpInstanceNo:            r.PublicIpInstanceNo,
+			PublicIp:                      r.PublicIp,
+			PublicIpDescription:           r.PublicIpDescription,
+			PublicIpInstanceStatusCode:    r.PublicIpInstanceStatus.Code,
+			ServerInstanceNo:              r.ServerInstanceNo,
+			PrivateIp:                     r.PrivateIp,
+			LastModifyDate:                r.LastModifyDate,
+			PublicIpInstanceOperationCode: r.PublicIpInstanceOperation.Code,
+			ZoneCode:                      nil,
+		})
 	}
 
-	if err := validateOneResult(len(resp.PublicIpInstanceList)); err != nil {
-		return nil, err
-	}
-
-	r := resp.PublicIpInstanceList[0]
-
-	p := &PublicIpInstance{
-		PublicIpInstanceNo:            r.PublicIpInstanceNo,
-		PublicIp:                      r.PublicIp,
-		PublicIpDescription:           r.PublicIpDescription,
-		PublicIpInstanceStatusCode:    r.PublicIpInstanceStatus.Code,
-		ServerInstanceNo:              r.ServerInstanceNo,
-		PrivateIp:                     r.PrivateIp,
-		LastModifyDate:                r.LastModifyDate,
-		PublicIpInstanceOperationCode: r.PublicIpInstanceOperation.Code,
-		ZoneCode:                      nil,
-	}
-
-	return p, nil
+	return instances, nil
 }
 
 func checkAssociatedPublicIP(config *ProviderConfig, id string) (bool, error) {
-	instance, err := getPublicIp(config, id)
+	instance, err := config.PublicIpService.Get(id)
 
 	if err != nil {
 		return false, err
@@ -396,20 +420,12 @@ func checkAssociatedPublicIP(config *ProviderConfig, id string) (bool, error) {
 	return instance.ServerInstanceNo != nil && *instance.ServerInstanceNo != "", nil
 }
 
-func disassociatedPublicIp(config *ProviderConfig, id string) error {
-	var err error
-
-	if config.SupportVPC {
-		err = disassociatedVpcPublicIp(config, id)
-	} else {
-		err = disassociatedClassicPublicIp(config, id)
-	}
-
-	if err != nil {
+func disassociatedPublicIp(ctx context.Context, config *ProviderConfig, id string) error {
+	if err := config.PublicIpService.Disassociate(id); err != nil {
 		return err
 	}
 
-	if err := waitForPublicIpDisassociation(config, id); err != nil {
+	if err := waitForPublicIpDisassociation(ctx, config, id); err != nil {
 		return err
 	}
 
@@ -446,7 +462,7 @@ func disassociatedVpcPublicIp(config *ProviderConfig, id string) error {
 	return nil
 }
 
-func waitForPublicIpDisassociation(config *ProviderConfig, id string) error {
+func waitForPublicIpDisassociation(ctx context.Context, config *ProviderConfig, id string) error {
 	stateConf := &resource.StateChangeConf{
 		Pending: []string{"NOT OK"},
 		Target:  []string{"OK"},
@@ -469,7 +485,7 @@ func waitForPublicIpDisassociation(config *ProviderConfig, id string) error {
 		MinTimeout: 3 * time.Second,
 	}
 
-	_, err := stateConf.WaitForState()
+	_, err := stateConf.WaitForStateContext(ctx)
 	if err != nil {
 		return fmt.Errorf("Error waiting for Public IP (%s) to become disassociation: %s", id, err)
 	}
@@ -478,14 +494,14 @@ func waitForPublicIpDisassociation(config *ProviderConfig, id string) error {
 }
 
 func getPublicIpInstanceOperationCode(config *ProviderConfig, id string) (string, error) {
-	instance, err := getPublicIp(config, id)
+	instance, err := config.PublicIpService.Get(id)
 	if err != nil {
 		return "", err
 	}
 	return *instance.PublicIpInstanceOperationCode, nil
 }
 
-func waitForPublicIpAssociation(config *ProviderConfig, id string) error {
+func waitForPublicIpAssociation(ctx context.Context, config *ProviderConfig, id string) error {
 	stateConf := &resource.StateChangeConf{
 		Pending: []string{"NOT OK"},
 		Target:  []string{"OK"},
@@ -507,7 +523,7 @@ func waitForPublicIpAssociation(config *ProviderConfig, id string) error {
 		MinTimeout: 3 * time.Second,
 	}
 
-	_, err := stateConf.WaitForState()
+	_, err := stateConf.WaitForStateContext(ctx)
 	if err != nil {
 		return fmt.Errorf("Error waiting for Public IP (%s) to become association: %s", id, err)
 	}
@@ -515,37 +531,29 @@ func waitForPublicIpAssociation(config *ProviderConfig, id string) error {
 	return nil
 }
 
-func associatedPublicIp(d *schema.ResourceData, config *ProviderConfig) error {
-	var err error
-
-	if config.SupportVPC {
-		err = associatedVpcPublicIp(d, config)
-	} else {
-		err = associatedClassicPublicIp(d, config)
-	}
-
-	if err != nil {
+func associatedPublicIp(ctx context.Context, config *ProviderConfig, id string, serverInstanceNo string) error {
+	if err := config.PublicIpService.Associate(id, serverInstanceNo); err != nil {
 		return err
 	}
 
-	if err := waitForPublicIpAssociation(config, d.Id()); err != nil {
+	if err := waitForPublicIpAssociation(ctx, config, id); err != nil {
 		return err
 	}
 
 	return nil
 }
 
-func associatedClassicPublicIp(d *schema.ResourceData, config *ProviderConfig) error {
+func associatedClassicPublicIp(config *ProviderConfig, id string, serverInstanceNo string) error {
 	reqParams := &server.AssociatePublicIpWithServerInstanceRequest{
-		PublicIpInstanceNo: ncloud.String(d.Id()),
-		ServerInstanceNo:   ncloud.String(d.Get("server_instance_no").(string)),
+		PublicIpInstanceNo: ncloud.String(id),
+		ServerInstanceNo:   ncloud.String(serverInstanceNo),
 	}
 
 	logCommonRequest("associatedClassicPublicIp", reqParams)
 
 	resp, err := config.Client.server.V2Api.AssociatePublicIpWithServerInstance(reqParams)
 	if err != nil {
-		logErrorResponse("associatedClassicPublicIp", err, d.Id())
+		logErrorResponse("associatedClassicPublicIp", err, id)
 		return err
 	}
 	logCommonResponse("associatedClassicPublicIp", GetCommonResponse(resp))
@@ -553,18 +561,18 @@ func associatedClassicPublicIp(d *schema.ResourceData, config *ProviderConfig) e
 	return nil
 }
 
-func associatedVpcPublicIp(d *schema.ResourceData, config *ProviderConfig) error {
+func associatedVpcPublicIp(config *ProviderConfig, id string, serverInstanceNo string) error {
 	reqParams := &vserver.AssociatePublicIpWithServerInstanceRequest{
 		RegionCode:         &config.RegionCode,
-		PublicIpInstanceNo: ncloud.String(d.Id()),
-		ServerInstanceNo:   ncloud.String(d.Get("server_instance_no").(string)),
+		PublicIpInstanceNo: ncloud.String(id),
+		ServerInstanceNo:   ncloud.String(serverInstanceNo),
 	}
 
 	logCommonRequest("associatedVpcPublicIp", reqParams)
 
 	resp, err := config.Client.vserver.V2Api.AssociatePublicIpWithServerInstance(reqParams)
 	if err != nil {
-		logErrorResponse("associatedVpcPublicIp", err, d.Id())
+		logErrorResponse("associatedVpcPublicIp", err, id)
 		return err
 	}
 	logCommonResponse("associatedVpcPublicIp", GetCommonResponse(resp))
@@ -573,7 +581,10 @@ func associatedVpcPublicIp(d *schema.ResourceData, config *ProviderConfig) error
 }
 
 func resourceNcloudPublicIpCustomizeDiff(_ context.Context, diff *schema.ResourceDiff, meta interface{}) error {
-	config := meta.(*ProviderConfig)
+	config, err := resourcePlatformConfig(diff, meta)
+	if err != nil {
+		return err
+	}
 
 	if config.SupportVPC {
 		if v, ok := diff.GetOk("zone"); ok {
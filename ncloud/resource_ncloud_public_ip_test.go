@@ -1,10 +1,12 @@
 package ncloud
 
 import (
+	"context"
 	"fmt"
 	"regexp"
 	"testing"
 
+	"github.com/NaverCloudPlatform/ncloud-sdk-go-v2/ncloud"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
@@ -12,6 +14,84 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 )
 
+// fakePublicIpService public IP service of the instances in memory
+type fakePublicIpService struct {
+	instances map[string]*PublicIpInstance
+	deleted   []string
+}
+
+func (s *fakePublicIpService) Create(d *schema.ResourceData) (*string, error) {
+	id := fmt.Sprintf("%d", len(s.instances)+1)
+	s.instances[id] = &PublicIpInstance{
+		PublicIpInstanceNo:  ncloud.String(id),
+		PublicIp:            ncloud.String("10.0.0." + id),
+		PublicIpDescription: StringPtrOrNil(d.GetOk("description")),
+	}
+	return ncloud.String(id), nil
+}
+
+func (s *fakePublicIpService) Get(id string) (*PublicIpInstance, error) {
+	return s.instances[id], nil
+}
+
+func (s *fakePublicIpService) Delete(id string) error {
+	delete(s.instances, id)
+	s.deleted = append(s.deleted, id)
+	return nil
+}
+
+func (s *fakePublicIpService) Associate(id string, serverInstanceNo string) error {
+	s.instances[id].ServerInstanceNo = ncloud.String(serverInstanceNo)
+	return nil
+}
+
+func (s *fakePublicIpService) Disassociate(id string) error {
+	s.instances[id].ServerInstanceNo = nil
+	return nil
+}
+
+func TestResourceNcloudPublicIp_fakeService(t *testing.T) {
+	service := &fakePublicIpService{instances: map[string]*PublicIpInstance{}}
+	config := &ProviderConfig{PublicIpService: service}
+
+	d := schema.TestResourceDataRaw(t, resourceNcloudPublicIpInstance().Schema, map[string]interface{}{
+		"description": "test",
+	})
+
+	if diags := resourceNcloudPublicIpCreate(context.Background(), d, config); diags.HasError() {
+		t.Fatalf("Unexpected error: %v", diags)
+	}
+
+	if d.Id() != "1" {
+		t.Fatalf("Expected: 1, Actual: %s", d.Id())
+	}
+	if v := d.Get("public_ip").(string); v != "10.0.0.1" {
+		t.Fatalf("Expected: 10.0.0.1, Actual: %s", v)
+	}
+	if v := d.Get("public_ip_no").(string); v != "1" {
+		t.Fatalf("Expected: 1, Actual: %s", v)
+	}
+	if v := d.Get("description").(string); v != "test" {
+		t.Fatalf("Expected: test, Actual: %s", v)
+	}
+
+	if diags := resourceNcloudPublicIpDelete(context.Background(), d, config); diags.HasError() {
+		t.Fatalf("Unexpected error: %v", diags)
+	}
+	if len(service.deleted) != 1 || service.deleted[0] != "1" {
+		t.Fatalf("Expected: [1], Actual: %v", service.deleted)
+	}
+
+	// removed outside of terraform
+	d.SetId("1")
+	if diags := resourceNcloudPublicIpRead(context.Background(), d, config); diags.HasError() {
+		t.Fatalf("Unexpected error: %v", diags)
+	}
+	if d.Id() != "" {
+		t.Fatalf("Expected empty id, Actual: %s", d.Id())
+	}
+}
+
 func TestAccResourceNcloudPublicIpInstance_classic_basic(t *testing.T) {
 	var instance *PublicIpInstance
 	description := fmt.Sprintf("test-public-ip-basic-%s", acctest.RandString(5))
@@ -156,7 +236,7 @@ func testAccCheckPublicIpInstanceExists(n string, i *PublicIpInstance, provider
 
 		config := provider.Meta().(*ProviderConfig)
 
-		instance, err := getPublicIp(config, rs.Primary.ID)
+		instance, err := config.PublicIpService.Get(rs.Primary.ID)
 
 		if err != nil {
 			return nil
@@ -180,7 +260,7 @@ func testAccCheckPublicIpInstanceDestroy(s *terraform.State, provider *schema.Pr
 			continue
 		}
 
-		instance, err := getPublicIp(config, rs.Primary.ID)
+		instance, err := config.PublicIpService.Get(rs.Primary.ID)
 
 		if err != nil {
 			return err
@@ -2,6 +2,7 @@ package ncloud
 
 import (
 	"bytes"
+	"context"
 	"fmt"
 	"log"
 	"strings"
@@ -9,6 +10,7 @@ import (
 
 	"github.com/NaverCloudPlatform/ncloud-sdk-go-v2/ncloud"
 	"github.com/NaverCloudPlatform/ncloud-sdk-go-v2/services/vpc"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
@@ -20,12 +22,12 @@ func init() {
 
 func resourceNcloudRoute() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceNcloudRouteCreate,
-		Read:   resourceNcloudRouteRead,
-		Update: resourceNcloudRouteUpdate,
-		Delete: resourceNcloudRouteDelete,
+		CreateContext: resourceNcloudRouteCreate,
+		ReadContext:   resourceNcloudRouteRead,
+		UpdateContext: resourceNcloudRouteUpdate,
+		DeleteContext: resourceNcloudRouteDelete,
 		Importer: &schema.ResourceImporter{
-			State: func(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+			StateContext: func(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
 				idParts := strings.Split(d.Id(), ":")
 				if len(idParts) != 2 || idParts[0] == "" || idParts[1] == "" {
 					return nil, fmt.Errorf("unexpected format of ID (%q), expected ROUTE_TABLE_NO:DESTINATION_CIDR_BLOCK", d.Id())
@@ -78,20 +80,20 @@ func resourceNcloudRoute() *schema.Resource {
 	}
 }
 
-func resourceNcloudRouteCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceNcloudRouteCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	config := meta.(*ProviderConfig)
 
 	if !config.SupportVPC {
-		return NotSupportClassic("resource `resource_ncloud_route`")
+		return diag.FromErr(NotSupportClassic("resource `resource_ncloud_route`"))
 	}
 
 	routeTable, err := getRouteTableInstance(config, d.Get("route_table_no").(string))
 	if err != nil {
-		return err
+		return ToDiagnostics(err)
 	}
 
 	if routeTable == nil {
-		return fmt.Errorf("No matching route table: %s", d.Get("route_table_no"))
+		return diag.FromErr(fmt.Errorf("No matching route table: %s", d.Get("route_table_no")))
 	}
 
 	routeParams := &vpc.RouteParameter{
@@ -109,18 +111,16 @@ func resourceNcloudRouteCreate(d *schema.ResourceData, meta interface{}) error {
 	}
 
 	var resp *vpc.AddRouteResponse
-	err = resource.Retry(d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
+	err = resource.RetryContext(ctx, d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
 		var err error
 
 		logCommonRequest("AddRoute", reqParams)
 		resp, err = config.Client.vpc.V2Api.AddRoute(reqParams)
 
 		if err != nil {
-			errBody, _ := GetCommonErrorBody(err)
-			if errBody.ReturnCode == "1017013" {
+			if apiErrorCode(err) == "1017013" {
 				logErrorResponse("retry add Route", err, reqParams)
-				time.Sleep(time.Second * 5)
-				return resource.RetryableError(err)
+				return retryableErrorAfter(ctx, time.Second*5, err)
 			}
 			return resource.NonRetryableError(err)
 		}
@@ -129,7 +129,7 @@ func resourceNcloudRouteCreate(d *schema.ResourceData, meta interface{}) error {
 
 	if err != nil {
 		logErrorResponse("AddRoute", err, reqParams)
-		return err
+		return ToDiagnostics(err)
 	}
 
 	logResponse("AddRoute", resp)
@@ -138,19 +138,19 @@ func resourceNcloudRouteCreate(d *schema.ResourceData, meta interface{}) error {
 
 	log.Printf("[INFO] Route ID: %s", d.Id())
 
-	if err := waitForNcloudRouteTableUpdate(config, d.Get("route_table_no").(string)); err != nil {
-		return err
+	if err := waitForNcloudRouteTableUpdate(ctx, config, d.Get("route_table_no").(string)); err != nil {
+		return ToDiagnostics(err)
 	}
 
-	return resourceNcloudRouteRead(d, meta)
+	return resourceNcloudRouteRead(ctx, d, meta)
 }
 
-func resourceNcloudRouteRead(d *schema.ResourceData, meta interface{}) error {
+func resourceNcloudRouteRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	config := meta.(*ProviderConfig)
 
 	routeTable, err := getRouteTableInstance(config, d.Get("route_table_no").(string))
 	if err != nil {
-		return err
+		return ToDiagnostics(err)
 	}
 
 	if routeTable != nil {
@@ -162,11 +162,10 @@ func resourceNcloudRouteRead(d *schema.ResourceData, meta interface{}) error {
 
 	instance, err := getRouteInstance(config, d)
 	if err != nil {
-		errBody, _ := GetCommonErrorBody(err)
-		if errBody.ReturnCode == "1017007" { // Route Table was not found
+		if apiErrorCode(err) == "1017007" { // Route Table was not found
 			d.SetId("")
 		}
-		return err
+		return ToDiagnostics(err)
 	}
 
 	if instance == nil {
@@ -185,11 +184,11 @@ func resourceNcloudRouteRead(d *schema.ResourceData, meta interface{}) error {
 	return nil
 }
 
-func resourceNcloudRouteUpdate(d *schema.ResourceData, meta interface{}) error {
-	return resourceNcloudRouteRead(d, meta)
+func resourceNcloudRouteUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	return resourceNcloudRouteRead(ctx, d, meta)
 }
 
-func resourceNcloudRouteDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceNcloudRouteDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	config := meta.(*ProviderConfig)
 
 	routeParams := &vpc.RouteParameter{
@@ -207,18 +206,16 @@ func resourceNcloudRouteDelete(d *schema.ResourceData, meta interface{}) error {
 	}
 
 	var resp *vpc.RemoveRouteResponse
-	err := resource.Retry(d.Timeout(schema.TimeoutDelete), func() *resource.RetryError {
+	err := resource.RetryContext(ctx, d.Timeout(schema.TimeoutDelete), func() *resource.RetryError {
 		var err error
 
 		logCommonRequest("RemoveRoute", reqParams)
 		resp, err = config.Client.vpc.V2Api.RemoveRoute(reqParams)
 
 		if err != nil {
-			errBody, _ := GetCommonErrorBody(err)
-			if errBody.ReturnCode == "1017013" {
+			if apiErrorCode(err) == "1017013" {
 				logErrorResponse("retry remove Route", err, reqParams)
-				time.Sleep(time.Second * 5)
-				return resource.RetryableError(err)
+				return retryableErrorAfter(ctx, time.Second*5, err)
 			}
 			return resource.NonRetryableError(err)
 		}
@@ -227,32 +224,33 @@ func resourceNcloudRouteDelete(d *schema.ResourceData, meta interface{}) error {
 
 	if err != nil {
 		logErrorResponse("RemoveRoute", err, reqParams)
-		return err
+		return ToDiagnostics(err)
 	}
 
 	logResponse("RemoveRoute", resp)
 
-	if err := waitForNcloudRouteTableUpdate(config, d.Get("route_table_no").(string)); err != nil {
-		return err
+	if err := waitForNcloudRouteTableUpdate(ctx, config, d.Get("route_table_no").(string)); err != nil {
+		return ToDiagnostics(err)
 	}
 
 	return nil
 }
 
-func waitForNcloudRouteTableUpdate(config *ProviderConfig, id string) error {
-	stateConf := &resource.StateChangeConf{
+func waitForNcloudRouteTableUpdate(ctx context.Context, config *ProviderConfig, id string) error {
+	waiter := &Waiter[vpc.RouteTable]{
 		Pending: []string{"SET"},
 		Target:  []string{"RUN"},
-		Refresh: func() (interface{}, string, error) {
-			instance, err := getRouteTableInstance(config, id)
-			return VpcCommonStateRefreshFunc(instance, err, "RouteTableStatus")
+		Refresh: func() (*vpc.RouteTable, error) {
+			return getRouteTableInstance(config, id)
 		},
-		Timeout:    DefaultTimeout,
-		Delay:      2 * time.Second,
-		MinTimeout: 3 * time.Second,
+		Status: func(instance *vpc.RouteTable) string {
+			return commonCodeValue(instance.RouteTableStatus)
+		},
+		Timeout: DefaultTimeout,
+		Delay:   2 * time.Second,
 	}
 
-	if _, err := stateConf.WaitForState(); err != nil {
+	if _, err := waiter.Wait(ctx); err != nil {
 		return fmt.Errorf("Error waiting for Route Table (%s) to become running: %s", id, err)
 	}
 
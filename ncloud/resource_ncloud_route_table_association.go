@@ -1,6 +1,7 @@
 package ncloud
 
 import (
+	"context"
 	"fmt"
 	"log"
 	"strings"
@@ -8,7 +9,7 @@ import (
 
 	"github.com/NaverCloudPlatform/ncloud-sdk-go-v2/ncloud"
 	"github.com/NaverCloudPlatform/ncloud-sdk-go-v2/services/vpc"
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
@@ -18,12 +19,12 @@ func init() {
 
 func resourceNcloudRouteTableAssociation() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceNcloudRouteTableAssociationCreate,
-		Read:   resourceNcloudRouteTableAssociationRead,
-		Update: resourceNcloudRouteTableAssociationUpdate,
-		Delete: resourceNcloudRouteTableAssociationDelete,
+		CreateContext: resourceNcloudRouteTableAssociationCreate,
+		ReadContext:   resourceNcloudRouteTableAssociationRead,
+		UpdateContext: resourceNcloudRouteTableAssociationUpdate,
+		DeleteContext: resourceNcloudRouteTableAssociationDelete,
 		Importer: &schema.ResourceImporter{
-			State: func(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+			StateContext: func(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
 				routeTableNo, subnetNo, err := convInstanceID(d.Id())
 				if err != nil {
 					return nil, err
@@ -49,20 +50,20 @@ func resourceNcloudRouteTableAssociation() *schema.Resource {
 	}
 }
 
-func resourceNcloudRouteTableAssociationCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceNcloudRouteTableAssociationCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	config := meta.(*ProviderConfig)
 
 	if !config.SupportVPC {
-		return NotSupportClassic("resource `ncloud_route_table_association`")
+		return diag.FromErr(NotSupportClassic("resource `ncloud_route_table_association`"))
 	}
 
 	routeTable, err := getRouteTableInstance(config, d.Get("route_table_no").(string))
 	if err != nil {
-		return err
+		return ToDiagnostics(err)
 	}
 
 	if routeTable == nil {
-		return fmt.Errorf("No matching route table: %s", d.Get("route_table_no"))
+		return diag.FromErr(fmt.Errorf("No matching route table: %s", d.Get("route_table_no")))
 	}
 
 	reqParams := &vpc.AddRouteTableSubnetRequest{
@@ -76,7 +77,7 @@ func resourceNcloudRouteTableAssociationCreate(d *schema.ResourceData, meta inte
 	resp, err := config.Client.vpc.V2Api.AddRouteTableSubnet(reqParams)
 	if err != nil {
 		logErrorResponse("AddRouteTableSubnet", err, reqParams)
-		return err
+		return ToDiagnostics(err)
 	}
 
 	logResponse("AddRouteTableSubnet", resp)
@@ -85,28 +86,28 @@ func resourceNcloudRouteTableAssociationCreate(d *schema.ResourceData, meta inte
 
 	log.Printf("[INFO] Association ID: %s", d.Id())
 
-	if err := waitForNcloudRouteTableAssociationTableUpdate(config, d.Get("route_table_no").(string)); err != nil {
-		return err
+	if err := waitForNcloudRouteTableAssociationTableUpdate(ctx, config, d.Get("route_table_no").(string)); err != nil {
+		return ToDiagnostics(err)
 	}
 
-	return resourceNcloudRouteTableAssociationRead(d, meta)
+	return resourceNcloudRouteTableAssociationRead(ctx, d, meta)
 }
 
-func resourceNcloudRouteTableAssociationRead(d *schema.ResourceData, meta interface{}) error {
+func resourceNcloudRouteTableAssociationRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	config := meta.(*ProviderConfig)
 
 	routeTable, err := getRouteTableInstance(config, d.Get("route_table_no").(string))
 	if err != nil {
-		return err
+		return ToDiagnostics(err)
 	}
 
 	if routeTable == nil {
-		return fmt.Errorf("No matching route table: %s", d.Get("route_table_no"))
+		return diag.FromErr(fmt.Errorf("No matching route table: %s", d.Get("route_table_no")))
 	}
 
 	instance, err := getRouteTableAssociationInstance(config, d.Id())
 	if err != nil {
-		return err
+		return ToDiagnostics(err)
 	}
 
 	if instance == nil {
@@ -121,20 +122,20 @@ func resourceNcloudRouteTableAssociationRead(d *schema.ResourceData, meta interf
 	return nil
 }
 
-func resourceNcloudRouteTableAssociationUpdate(d *schema.ResourceData, meta interface{}) error {
-	return resourceNcloudRouteTableAssociationRead(d, meta)
+func resourceNcloudRouteTableAssociationUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	return resourceNcloudRouteTableAssociationRead(ctx, d, meta)
 }
 
-func resourceNcloudRouteTableAssociationDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceNcloudRouteTableAssociationDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	config := meta.(*ProviderConfig)
 
 	routeTable, err := getRouteTableInstance(config, d.Get("route_table_no").(string))
 	if err != nil {
-		return err
+		return ToDiagnostics(err)
 	}
 
 	if routeTable == nil {
-		return fmt.Errorf("No matching route table: %s", d.Get("route_table_no"))
+		return diag.FromErr(fmt.Errorf("No matching route table: %s", d.Get("route_table_no")))
 	}
 
 	reqParams := &vpc.RemoveRouteTableSubnetRequest{
@@ -148,32 +149,33 @@ func resourceNcloudRouteTableAssociationDelete(d *schema.ResourceData, meta inte
 	resp, err := config.Client.vpc.V2Api.RemoveRouteTableSubnet(reqParams)
 	if err != nil {
 		logErrorResponse("RemoveRouteTableSubnet", err, reqParams)
-		return err
+		return ToDiagnostics(err)
 	}
 
 	logResponse("RemoveRouteTableSubnet", resp)
 
-	if err := waitForNcloudRouteTableAssociationTableUpdate(config, d.Get("route_table_no").(string)); err != nil {
-		return err
+	if err := waitForNcloudRouteTableAssociationTableUpdate(ctx, config, d.Get("route_table_no").(string)); err != nil {
+		return ToDiagnostics(err)
 	}
 
 	return nil
 }
 
-func waitForNcloudRouteTableAssociationTableUpdate(config *ProviderConfig, id string) error {
-	stateConf := &resource.StateChangeConf{
+func waitForNcloudRouteTableAssociationTableUpdate(ctx context.Context, config *ProviderConfig, id string) error {
+	waiter := &Waiter[vpc.RouteTable]{
 		Pending: []string{"SET"},
 		Target:  []string{"RUN"},
-		Refresh: func() (interface{}, string, error) {
-			instance, err := getRouteTableInstance(config, id)
-			return VpcCommonStateRefreshFunc(instance, err, "RouteTableStatus")
+		Refresh: func() (*vpc.RouteTable, error) {
+			return getRouteTableInstance(config, id)
 		},
-		Timeout:    DefaultTimeout,
-		Delay:      2 * time.Second,
-		MinTimeout: 3 * time.Second,
+		Status: func(instance *vpc.RouteTable) string {
+			return commonCodeValue(instance.RouteTableStatus)
+		},
+		Timeout: DefaultTimeout,
+		Delay:   2 * time.Second,
 	}
 
-	if _, err := stateConf.WaitForState(); err != nil {
+	if _, err := waiter.Wait(ctx); err != nil {
 		return fmt.Errorf("Error waiting for Route Table (%s) to become running: %s", id, err)
 	}
 
@@ -1,6 +1,7 @@
 package ncloud
 
 import (
+	"context"
 	"errors"
 	"fmt"
 	"testing"
@@ -203,7 +204,7 @@ func testAccCheckRouteTableAssociationDisappears(instance *vpc.Subnet, routeTabl
 
 		_, err = config.Client.vpc.V2Api.RemoveRouteTableSubnet(reqParams)
 
-		if err := waitForNcloudRouteTableAssociationTableUpdate(config, *routeTableNo); err != nil {
+		if err := waitForNcloudRouteTableAssociationTableUpdate(context.Background(), config, *routeTableNo); err != nil {
 			return err
 		}
 
@@ -1,15 +1,18 @@
 package ncloud
 
 import (
+	"context"
 	"fmt"
 	"github.com/NaverCloudPlatform/ncloud-sdk-go-v2/services/vserver"
 	"log"
+	"reflect"
 	"regexp"
 	"strconv"
 	"time"
 
 	"github.com/NaverCloudPlatform/ncloud-sdk-go-v2/ncloud"
 	"github.com/NaverCloudPlatform/ncloud-sdk-go-v2/services/server"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
@@ -20,19 +23,22 @@ func init() {
 }
 
 func resourceNcloudServer() *schema.Resource {
-	return &schema.Resource{
-		Create: resourceNcloudServerCreate,
-		Read:   resourceNcloudServerRead,
-		Update: resourceNcloudServerUpdate,
-		Delete: resourceNcloudServerDelete,
+	r := &schema.Resource{
+		CreateContext: resourceNcloudServerCreate,
+		ReadContext:   resourceNcloudServerRead,
+		UpdateContext: resourceNcloudServerUpdate,
+		DeleteContext: resourceNcloudServerDelete,
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: resourceNcloudServerImportState,
 		},
+		CustomizeDiff: resourceNcloudServerCustomizeDiff,
 		Timeouts: &schema.ResourceTimeout{
 			Create: schema.DefaultTimeout(DefaultCreateTimeout),
 			Delete: schema.DefaultTimeout(DefaultTimeout),
 		},
+		SchemaVersion: 1,
 		Schema: map[string]*schema.Schema{
+			"platform": platformSchema(),
 			"server_image_product_code": {
 				Type:          schema.TypeString,
 				Optional:      true,
@@ -84,8 +90,8 @@ func resourceNcloudServer() *schema.Resource {
 				Type:             schema.TypeString,
 				Optional:         true,
 				Computed:         true,
-				ForceNew:         true,
 				ValidateDiagFunc: ToDiagFunc(validation.StringInSlice([]string{"PUBLC", "GLBL"}, false)),
+				DiffSuppressFunc: suppressDeprecatedDiff,
 				Deprecated:       "This parameter is no longer used.",
 			},
 			"fee_system_type_code": {
@@ -124,12 +130,26 @@ func resourceNcloudServer() *schema.Resource {
 						"tag_key": {
 							Type:     schema.TypeString,
 							Optional: true,
-							ForceNew: true,
 						},
 						"tag_value": {
 							Type:     schema.TypeString,
 							Optional: true,
-							ForceNew: true,
+						},
+					},
+				},
+			},
+			"tag_list_all": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"tag_key": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"tag_value": {
+							Type:     schema.TypeString,
+							Computed: true,
 						},
 					},
 				},
@@ -254,29 +274,44 @@ func resourceNcloudServer() *schema.Resource {
 			},
 		},
 	}
+	r.StateUpgraders = []schema.StateUpgrader{
+		resourceNcloudServerStateUpgradeV0(r),
+	}
+
+	return r
 }
 
-func resourceNcloudServerCreate(d *schema.ResourceData, meta interface{}) error {
-	config := meta.(*ProviderConfig)
+// resourceNcloudServerStateUpgradeV0 clears the deprecated `internet_line_type`, which the API no longer uses for the server
+func resourceNcloudServerStateUpgradeV0(r *schema.Resource) schema.StateUpgrader {
+	return stateUpgrader(0, r, clearStateAttributes("internet_line_type"))
+}
 
-	id, err := createServerInstance(d, config)
+func resourceNcloudServerCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config, err := resourcePlatformConfig(d, meta)
+	if err != nil {
+		return ToDiagnostics(err)
+	}
 
+	id, err := config.ServerService.Create(ctx, d)
 	if err != nil {
-		return err
+		return ToDiagnostics(err)
 	}
 
 	d.SetId(ncloud.StringValue(id))
 	log.Printf("[INFO] Server instance ID: %s", d.Id())
 
-	return resourceNcloudServerRead(d, meta)
+	return resourceNcloudServerRead(ctx, d, meta)
 }
 
-func resourceNcloudServerRead(d *schema.ResourceData, meta interface{}) error {
-	config := meta.(*ProviderConfig)
+func resourceNcloudServerRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config, err := resourcePlatformConfig(d, meta)
+	if err != nil {
+		return ToDiagnostics(err)
+	}
 
-	r, err := getServerInstance(config, d.Id())
+	r, err := config.readServer(d.Id())
 	if err != nil {
-		return err
+		return ToDiagnostics(err)
 	}
 
 	if r == nil {
@@ -284,82 +319,145 @@ func resourceNcloudServerRead(d *schema.ResourceData, meta interface{}) error {
 		return nil
 	}
 
-	if config.SupportVPC {
-		buildNetworkInterfaceList(config, r)
-	}
+	// Network interfaces are returned on VPC only
+	buildNetworkInterfaceList(config, r)
+
+	// Only the tags managed by Terraform are compared
+	keys, allKeys := managedTagKeys(d.Get("tag_list").([]interface{}), config.DefaultTags)
+	tagListAll := filterInstanceTagList(r.InstanceTagList, allKeys)
+	r.InstanceTagList = filterInstanceTagList(r.InstanceTagList, keys)
 
 	instance := ConvertToMap(r)
 
 	SetSingularResourceDataFromMapSchema(resourceNcloudServer(), d, instance)
 
+	if err := d.Set("tag_list_all", flattenInstanceTagList(tagListAll)); err != nil {
+		return ToDiagnostics(err)
+	}
+
 	return nil
 }
 
-func resourceNcloudServerDelete(d *schema.ResourceData, meta interface{}) error {
-	config := meta.(*ProviderConfig)
-	serverInstance, err := getServerInstance(config, d.Id())
+// resourceNcloudServerImportState takes the tags of the server other than default_tags as tag_list, as only the tags in tag_list are read afterwards
+func resourceNcloudServerImportState(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	config, err := resourcePlatformConfig(d, meta)
 	if err != nil {
-		return err
+		return nil, err
+	}
+
+	r, err := config.readServer(d.Id())
+	if err != nil {
+		return nil, err
+	}
+
+	if r == nil {
+		return nil, fmt.Errorf("server instance (%s) not found", d.Id())
+	}
+
+	if err := d.Set("tag_list", flattenInstanceTagList(importedTagList(r.InstanceTagList, config.DefaultTags))); err != nil {
+		return nil, err
+	}
+
+	return []*schema.ResourceData{d}, nil
+}
+
+func resourceNcloudServerDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config, err := resourcePlatformConfig(d, meta)
+	if err != nil {
+		return ToDiagnostics(err)
+	}
+
+	serverInstance, err := config.ServerService.Get(d.Id())
+	if err != nil {
+		return ToDiagnostics(err)
 	}
 
 	if ncloud.StringValue(serverInstance.ServerInstanceStatus) != "NSTOP" {
 		log.Printf("[INFO] Stopping Instance %q for terminate", d.Id())
-		if err := stopThenWaitServerInstance(config, d.Id()); err != nil {
-			return err
+		if err := stopThenWaitServerInstance(ctx, config, d.Id()); err != nil {
+			return ToDiagnostics(err)
 		}
 	}
 
-	blockStorageList, err := getAdditionalBlockStorageList(config, d.Id())
+	blockStorageList, err := config.BlockStorageService.GetAdditionalList(d.Id())
 	if err != nil {
-		return err
+		return ToDiagnostics(err)
 	}
 
 	if len(blockStorageList) > 0 {
 		for _, blockStorage := range blockStorageList {
-			if err := disconnectBlockStorage(config, blockStorage); err != nil {
-				return err
+			if err := config.BlockStorageService.Detach(*blockStorage.BlockStorageInstanceNo); err != nil {
+				return ToDiagnostics(err)
 			}
 
-			if err := waitForDisconnectBlockStorage(config, d, blockStorage); err != nil {
-				return err
+			if err := waitForDisconnectBlockStorage(ctx, config, d, blockStorage); err != nil {
+				return ToDiagnostics(err)
 			}
 		}
 	}
 
-	if err := terminateThenWaitServerInstance(config, d.Id()); err != nil {
-		return err
+	if err := terminateThenWaitServerInstance(ctx, config, d.Id()); err != nil {
+		return ToDiagnostics(err)
 	}
 	d.SetId("")
 	return nil
 }
 
-func resourceNcloudServerUpdate(d *schema.ResourceData, meta interface{}) error {
-	config := meta.(*ProviderConfig)
+func resourceNcloudServerUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config, err := resourcePlatformConfig(d, meta)
+	if err != nil {
+		return ToDiagnostics(err)
+	}
 
 	if d.HasChange("server_product_code") {
-		if err := updateServerInstanceSpec(d, config); err != nil {
-			return err
+		if err := updateServerInstanceSpec(ctx, d, config); err != nil {
+			return ToDiagnostics(err)
 		}
 	}
 
 	if d.HasChange("is_protect_server_termination") {
-		if err := updateServerProtectionTermination(d, config); err != nil {
-			return err
+		if err := config.ServerService.SetProtectServerTermination(d.Id(), d.Get("is_protect_server_termination").(bool)); err != nil {
+			return ToDiagnostics(err)
+		}
+	}
+
+	if d.HasChanges("tag_list", "tag_list_all") {
+		if err := config.ServerService.UpdateTags(d); err != nil {
+			return ToDiagnostics(err)
 		}
 	}
 
-	return resourceNcloudServerRead(d, meta)
+	return resourceNcloudServerRead(ctx, d, meta)
 }
 
-func createServerInstance(d *schema.ResourceData, config *ProviderConfig) (*string, error) {
+// resourceNcloudServerCustomizeDiff rejects tag_list on VPC, where the server API has no tags yet,
+// and plans tag_list_all with default tags of the provider so that changes of default_tags are applied in place
+func resourceNcloudServerCustomizeDiff(_ context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+	config, err := resourcePlatformConfig(diff, meta)
+	if err != nil {
+		return err
+	}
+
 	if config.SupportVPC {
-		return createVpcServerInstance(d, config)
+		if len(diff.Get("tag_list").([]interface{})) > 0 {
+			return NewArgumentError("tag_list", NotSupportVpc("argument `tag_list` of resource `ncloud_server`"))
+		}
+		return nil
 	}
 
-	return createClassicServerInstance(d, config)
+	if diff.Id() == "" {
+		return nil
+	}
+
+	tagListAll := mergeDefaultTagList(config.DefaultTags, diff.Get("tag_list").([]interface{}))
+	if !reflect.DeepEqual(tagListToMap(tagListAll), tagListToMap(diff.Get("tag_list_all").([]interface{}))) {
+		return diff.SetNew("tag_list_all", tagListAll)
+	}
+
+	return nil
 }
 
-func createClassicServerInstance(d *schema.ResourceData, config *ProviderConfig) (*string, error) {
+func createClassicServerInstance(ctx context.Context, d *schema.ResourceData, config *ProviderConfig) (*string, error) {
 	zoneNo, err := parseZoneNoParameter(config, d)
 	if err != nil {
 		return nil, err
@@ -379,7 +477,7 @@ func createClassicServerInstance(d *schema.ResourceData, config *ProviderConfig)
 		RaidTypeName:               StringPtrOrNil(d.GetOk("raid_type_name")),
 	}
 
-	if instanceTagList, err := expandTagListParams(d.Get("tag_list").([]interface{})); err == nil {
+	if instanceTagList, err := expandTagListParams(mergeDefaultTagList(config.DefaultTags, d.Get("tag_list").([]interface{}))); err == nil {
 		reqParams.InstanceTagList = instanceTagList
 	}
 
@@ -388,13 +486,12 @@ func createClassicServerInstance(d *schema.ResourceData, config *ProviderConfig)
 	}
 
 	var resp *server.CreateServerInstancesResponse
-	err = resource.Retry(10*time.Minute, func() *resource.RetryError {
+	err = resource.RetryContext(ctx, 10*time.Minute, func() *resource.RetryError {
 		var err error
 		logCommonRequest("createClassicServerInstance", reqParams)
 		resp, err = config.Client.server.V2Api.CreateServerInstances(reqParams)
 		if err != nil {
-			errBody, _ := GetCommonErrorBody(err)
-			if containsInStringList(errBody.ReturnCode, []string{ApiErrorUnknown, ApiErrorAuthorityParameter, ApiErrorServerObjectInOperation, ApiErrorPreviousServersHaveNotBeenEntirelyTerminated}) {
+			if containsInStringList(apiErrorCode(err), []string{ApiErrorUnknown, ApiErrorAuthorityParameter, ApiErrorServerObjectInOperation, ApiErrorPreviousServersHaveNotBeenEntirelyTerminated}) {
 				return resource.RetryableError(err)
 			}
 			return resource.NonRetryableError(err)
@@ -411,24 +508,24 @@ func createClassicServerInstance(d *schema.ResourceData, config *ProviderConfig)
 
 	serverInstance := resp.ServerInstanceList[0]
 
-	if err := waitStateNcloudServerForCreation(config, *serverInstance.ServerInstanceNo); err != nil {
+	if err := waitStateNcloudServerForCreation(ctx, config, *serverInstance.ServerInstanceNo); err != nil {
 		return nil, err
 	}
 
 	return serverInstance.ServerInstanceNo, nil
 }
 
-func createVpcServerInstance(d *schema.ResourceData, config *ProviderConfig) (*string, error) {
+func createVpcServerInstance(ctx context.Context, d *schema.ResourceData, config *ProviderConfig) (*string, error) {
 	if _, ok := d.GetOk("subnet_no"); !ok {
 		return nil, ErrorRequiredArgOnVpc("subnet_no")
 	}
 
 	if _, ok := d.GetOk("access_control_group_configuration_no_list"); ok {
-		return nil, NotSupportVpc("`access_control_group_configuration_no_list` of ncloud_server")
+		return nil, NewArgumentError("access_control_group_configuration_no_list", NotSupportVpc("`access_control_group_configuration_no_list` of ncloud_server"))
 	}
 
 	if _, ok := d.GetOk("user_data"); ok {
-		return nil, NotSupportVpc("`user_data` of ncloud_server")
+		return nil, NewArgumentError("user_data", NotSupportVpc("`user_data` of ncloud_server"))
 	}
 
 	subnet, err := getSubnetInstance(config, d.Get("subnet_no").(string))
@@ -503,30 +600,32 @@ func createVpcServerInstance(d *schema.ResourceData, config *ProviderConfig) (*s
 	logResponse("createVpcServerInstance", resp)
 	serverInstance := resp.ServerInstanceList[0]
 
-	if err := waitStateNcloudServerForCreation(config, *serverInstance.ServerInstanceNo); err != nil {
+	if err := waitStateNcloudServerForCreation(ctx, config, *serverInstance.ServerInstanceNo); err != nil {
 		return nil, err
 	}
 
 	return serverInstance.ServerInstanceNo, nil
 }
 
-func waitStateNcloudServerForCreation(config *ProviderConfig, id string) error {
-	stateConf := &resource.StateChangeConf{
+func waitStateNcloudServerForCreation(ctx context.Context, config *ProviderConfig, id string) error {
+	waiter := &Waiter[ServerInstance]{
 		Pending: []string{"INIT", "CREAT"},
 		Target:  []string{"RUN"},
-		Refresh: func() (interface{}, string, error) {
-			instance, err := getServerInstance(config, id)
-			if err != nil {
-				return 0, "", err
-			}
-			return instance, ncloud.StringValue(instance.ServerInstanceStatus), nil
+		Name:    fmt.Sprintf("ServerInstance (%s)", id),
+		Refresh: func() (*ServerInstance, error) {
+			return config.ServerService.Get(id)
 		},
-		Timeout:    DefaultCreateTimeout,
-		Delay:      2 * time.Second,
-		MinTimeout: 3 * time.Second,
+		Status: func(instance *ServerInstance) string {
+			return ncloud.StringValue(instance.ServerInstanceStatus)
+		},
+		Operation: func(instance *ServerInstance) string {
+			return ncloud.StringValue(instance.ServerInstanceOperation)
+		},
+		Timeout: DefaultCreateTimeout,
+		Delay:   2 * time.Second,
 	}
 
-	_, err := stateConf.WaitForState()
+	_, err := waiter.Wait(ctx)
 	if err != nil {
 		return fmt.Errorf("error waiting for ServerInstance state to be \"RUN\": %s", err)
 	}
@@ -534,61 +633,51 @@ func waitStateNcloudServerForCreation(config *ProviderConfig, id string) error {
 	return nil
 }
 
-func updateServerInstanceSpec(d *schema.ResourceData, config *ProviderConfig) error {
-	serverInstance, err := getServerInstance(config, d.Id())
+func updateServerInstanceSpec(ctx context.Context, d *schema.ResourceData, config *ProviderConfig) error {
+	serverInstance, err := config.ServerService.Get(d.Id())
 	if err != nil {
 		return err
 	}
 
 	log.Printf("[INFO] Stopping Instance %q for server_product_code change", d.Id())
 	if ncloud.StringValue(serverInstance.ServerInstanceStatus) != "NSTOP" {
-		if err := stopThenWaitServerInstance(config, d.Id()); err != nil {
+		if err := stopThenWaitServerInstance(ctx, config, d.Id()); err != nil {
 			return err
 		}
 	}
 
-	if err := changeServerInstanceSpec(d, config); err != nil {
+	if err := changeServerInstanceSpec(ctx, d, config); err != nil {
 		return err
 	}
 
 	log.Printf("[INFO] Start Instance %q for server_product_code change", d.Id())
-	if err := startThenWaitServerInstance(config, d.Id()); err != nil {
+	if err := startThenWaitServerInstance(ctx, config, d.Id()); err != nil {
 		return err
 	}
 
 	return nil
 }
 
-func changeServerInstanceSpec(d *schema.ResourceData, config *ProviderConfig) error {
-	var err error
-	if config.SupportVPC {
-		err = changeVpcServerInstanceSpec(d, config)
-	} else {
-		err = changeClassicServerInstanceSpec(d, config)
-	}
-
-	if err != nil {
+func changeServerInstanceSpec(ctx context.Context, d *schema.ResourceData, config *ProviderConfig) error {
+	if err := config.ServerService.ChangeSpec(d.Id(), d.Get("server_product_code").(string)); err != nil {
 		return err
 	}
 
-	stateConf := &resource.StateChangeConf{
+	waiter := &Waiter[ServerInstance]{
 		Pending: []string{"CHNG"},
 		Target:  []string{"NULL"},
-		Refresh: func() (interface{}, string, error) {
-			instance, err := getServerInstance(config, d.Id())
-
-			if err != nil {
-				return 0, "", err
-			}
-
-			return instance, ncloud.StringValue(instance.ServerInstanceOperation), nil
+		Name:    fmt.Sprintf("ServerInstance (%s)", d.Id()),
+		Refresh: func() (*ServerInstance, error) {
+			return config.ServerService.Get(d.Id())
+		},
+		Status: func(instance *ServerInstance) string {
+			return ncloud.StringValue(instance.ServerInstanceOperation)
 		},
-		Timeout:    DefaultTimeout,
-		Delay:      2 * time.Second,
-		MinTimeout: 3 * time.Second,
+		Timeout: DefaultTimeout,
+		Delay:   2 * time.Second,
 	}
 
-	_, err = stateConf.WaitForState()
+	_, err := waiter.Wait(ctx)
 	if err != nil {
 		return fmt.Errorf("error waiting for ServerInstance operation to be \"NULL\": %s", err)
 	}
@@ -596,10 +685,10 @@ func changeServerInstanceSpec(d *schema.ResourceData, config *ProviderConfig) er
 	return nil
 }
 
-func changeClassicServerInstanceSpec(d *schema.ResourceData, config *ProviderConfig) error {
+func changeClassicServerInstanceSpec(config *ProviderConfig, id string, serverProductCode string) error {
 	reqParams := &server.ChangeServerInstanceSpecRequest{
-		ServerInstanceNo:  ncloud.String(d.Get("instance_no").(string)),
-		ServerProductCode: ncloud.String(d.Get("server_product_code").(string)),
+		ServerInstanceNo:  ncloud.String(id),
+		ServerProductCode: ncloud.String(serverProductCode),
 	}
 
 	logCommonRequest("changeClassicServerInstanceSpec", reqParams)
@@ -613,11 +702,11 @@ func changeClassicServerInstanceSpec(d *schema.ResourceData, config *ProviderCon
 	return nil
 }
 
-func changeVpcServerInstanceSpec(d *schema.ResourceData, config *ProviderConfig) error {
+func changeVpcServerInstanceSpec(config *ProviderConfig, id string, serverProductCode string) error {
 	reqParams := &vserver.ChangeServerInstanceSpecRequest{
 		RegionCode:        &config.RegionCode,
-		ServerInstanceNo:  ncloud.String(d.Get("instance_no").(string)),
-		ServerProductCode: ncloud.String(d.Get("server_product_code").(string)),
+		ServerInstanceNo:  ncloud.String(id),
+		ServerProductCode: ncloud.String(serverProductCode),
 	}
 
 	logCommonRequest("changeVpcServerInstanceSpec", reqParams)
@@ -631,19 +720,11 @@ func changeVpcServerInstanceSpec(d *schema.ResourceData, config *ProviderConfig)
 	return nil
 }
 
-func updateServerProtectionTermination(d *schema.ResourceData, config *ProviderConfig) error {
-	if config.SupportVPC {
-		return updateVpcServerProtectionTermination(d, config)
-	}
-
-	return updateClassicServerProtectionTermination(d, config)
-}
-
-func updateVpcServerProtectionTermination(d *schema.ResourceData, config *ProviderConfig) error {
+func updateVpcServerProtectionTermination(config *ProviderConfig, id string, protect bool) error {
 	reqParams := &vserver.SetProtectServerTerminationRequest{
 		RegionCode:                 &config.RegionCode,
-		ServerInstanceNo:           ncloud.String(d.Id()),
-		IsProtectServerTermination: ncloud.Bool(d.Get("is_protect_server_termination").(bool)),
+		ServerInstanceNo:           ncloud.String(id),
+		IsProtectServerTermination: ncloud.Bool(protect),
 	}
 
 	logCommonRequest("SetProtectServerTermination", reqParams)
@@ -657,10 +738,10 @@ func updateVpcServerProtectionTermination(d *schema.ResourceData, config *Provid
 	return nil
 }
 
-func updateClassicServerProtectionTermination(d *schema.ResourceData, config *ProviderConfig) error {
+func updateClassicServerProtectionTermination(config *ProviderConfig, id string, protect bool) error {
 	reqParams := &server.SetProtectServerTerminationRequest{
-		ServerInstanceNo:           ncloud.String(d.Id()),
-		IsProtectServerTermination: ncloud.Bool(d.Get("is_protect_server_termination").(bool)),
+		ServerInstanceNo:           ncloud.String(id),
+		IsProtectServerTermination: ncloud.Bool(protect),
 	}
 
 	logCommonRequest("SetProtectServerTermination", reqParams)
@@ -674,35 +755,67 @@ func updateClassicServerProtectionTermination(d *schema.ResourceData, config *Pr
 	return nil
 }
 
-func startThenWaitServerInstance(config *ProviderConfig, id string) error {
-	var err error
-	if config.SupportVPC {
-		err = startVpcServerInstance(config, id)
-	} else {
-		err = startClassicServerInstance(config, id)
+func updateClassicServerInstanceTags(d *schema.ResourceData, config *ProviderConfig) error {
+	oldTagList, _ := d.GetChange("tag_list_all")
+	newTagList := mergeDefaultTagList(config.DefaultTags, d.Get("tag_list").([]interface{}))
+	create, remove := diffTagList(oldTagList.([]interface{}), newTagList)
+
+	if len(remove) > 0 {
+		reqParams := &server.DeleteInstanceTagsRequest{
+			InstanceNoList:  []*string{ncloud.String(d.Id())},
+			InstanceTagList: remove,
+		}
+
+		logCommonRequest("DeleteInstanceTags", reqParams)
+		resp, err := config.Client.server.V2Api.DeleteInstanceTags(reqParams)
+		if err != nil {
+			logErrorResponse("DeleteInstanceTags", err, reqParams)
+			return err
+		}
+		logResponse("DeleteInstanceTags", resp)
 	}
 
-	if err != nil {
+	if len(create) > 0 {
+		reqParams := &server.CreateInstanceTagsRequest{
+			InstanceNoList:  []*string{ncloud.String(d.Id())},
+			InstanceTagList: create,
+		}
+
+		logCommonRequest("CreateInstanceTags", reqParams)
+		resp, err := config.Client.server.V2Api.CreateInstanceTags(reqParams)
+		if err != nil {
+			logErrorResponse("CreateInstanceTags", err, reqParams)
+			return err
+		}
+		logResponse("CreateInstanceTags", resp)
+	}
+
+	return nil
+}
+
+func startThenWaitServerInstance(ctx context.Context, config *ProviderConfig, id string) error {
+	if err := config.ServerService.Start(id); err != nil {
 		return err
 	}
 
-	stateConf := &resource.StateChangeConf{
+	waiter := &Waiter[ServerInstance]{
 		Pending: []string{"NSTOP"},
 		Target:  []string{"RUN"},
-		Refresh: func() (interface{}, string, error) {
-			instance, err := getServerInstance(config, id)
-			if err != nil {
-				return 0, "", err
-			}
-
-			return instance, ncloud.StringValue(instance.ServerInstanceStatus), nil
+		Name:    fmt.Sprintf("ServerInstance (%s)", id),
+		Refresh: func() (*ServerInstance, error) {
+			return config.ServerService.Get(id)
+		},
+		Status: func(instance *ServerInstance) string {
+			return ncloud.StringValue(instance.ServerInstanceStatus)
 		},
-		Timeout:    DefaultTimeout,
-		Delay:      2 * time.Second,
-		MinTimeout: 3 * time.Second,
+		Operation: func(instance *ServerInstance) string {
+			return ncloud.StringValue(instance.ServerInstanceOperation)
+		},
+		Timeout: DefaultTimeout,
+		Delay:   2 * time.Second,
 	}
 
-	_, err = stateConf.WaitForState()
+	_, err := waiter.Wait(ctx)
 	if err != nil {
 		return fmt.Errorf("error waiting for ServerInstance state to be \"RUN\": %s", err)
 	}
@@ -741,38 +854,45 @@ func startVpcServerInstance(config *ProviderConfig, id string) error {
 	return nil
 }
 
-func getServerInstance(config *ProviderConfig, id string) (*ServerInstance, error) {
-	if config.SupportVPC {
-		return getVpcServerInstance(config, id)
+func getClassicServerInstance(config *ProviderConfig, id string) (*ServerInstance, error) {
+	instances, err := getClassicServerInstanceList(config, []string{id})
+	if err != nil {
+		return nil, err
 	}
 
-	return getClassicServerInstance(config, id)
+	if len(instances) == 0 {
+		return nil, nil
+	}
+
+	if err := validateOneResult(len(instances)); err != nil {
+		return nil, err
+	}
+
+	return instances[0], nil
 }
 
-func getClassicServerInstance(config *ProviderConfig, id string) (*ServerInstance, error) {
+// getClassicServerInstanceList returns the servers found of the instance numbers
+func getClassicServerInstanceList(config *ProviderConfig, ids []string) ([]*ServerInstance, error) {
 	reqParams := &server.GetServerInstanceListRequest{
-		ServerInstanceNoList: []*string{ncloud.String(id)},
+		ServerInstanceNoList: ncloud.StringList(ids),
 	}
 
-	logCommonRequest("getClassicServerInstance", reqParams)
+	logCommonRequest("getClassicServerInstanceList", reqParams)
 	resp, err := config.Client.server.V2Api.GetServerInstanceList(reqParams)
 
 	if err != nil {
-		logErrorResponse("getClassicServerInstance", err, reqParams)
+		logErrorResponse("getClassicServerInstanceList", err, reqParams)
 		return nil, err
 	}
 
-	logResponse("getClassicServerInstance", resp)
-
-	if len(resp.ServerInstanceList) == 0 {
-		return nil, nil
-	}
+	logResponse("getClassicServerInstanceList", resp)
 
-	if err := validateOneResult(len(resp.ServerInstanceList)); err != nil {
-		return nil, err
+	instances := make([]*ServerInstance, 0, len(resp.ServerInstanceList))
+	for _, r := range resp.ServerInstanceList {
+		instances = append(instances, convertClassicServerInstance(r))
 	}
 
-	return convertClassicServerInstance(resp.ServerInstanceList[0]), nil
+	return instances, nil
 }
 
 func convertClassicServerInstance(r *server.ServerInstance) *ServerInstance {
@@ -836,6 +956,31 @@ func getVpcServerInstance(config *ProviderConfig, id string) (*ServerInstance, e
 	return convertVcpServerInstance(resp.ServerInstanceList[0]), nil
 }
 
+// getVpcServerInstanceList returns the servers found of the instance numbers
+func getVpcServerInstanceList(config *ProviderConfig, ids []string) ([]*ServerInstance, error) {
+	reqParams := &vserver.GetServerInstanceListRequest{
+		RegionCode:           &config.RegionCode,
+		ServerInstanceNoList: ncloud.StringList(ids),
+	}
+
+	logCommonRequest("getVpcServerInstanceList", reqParams)
+	resp, err := config.Client.vserver.V2Api.GetServerInstanceList(reqParams)
+
+	if err != nil {
+		logErrorResponse("getVpcServerInstanceList", err, reqParams)
+		return nil, err
+	}
+
+	logResponse("getVpcServerInstanceList", resp)
+
+	instances := make([]*ServerInstance, 0, len(resp.ServerInstanceList))
+	for _, r := range resp.ServerInstanceList {
+		instances = append(instances, convertVcpServerInstance(r))
+	}
+
+	return instances, nil
+}
+
 func convertVcpServerInstance(r *vserver.ServerInstance) *ServerInstance {
 	if r == nil {
 		return nil
@@ -876,14 +1021,26 @@ func convertVcpServerInstance(r *vserver.ServerInstance) *ServerInstance {
 }
 
 func buildNetworkInterfaceList(config *ProviderConfig, r *ServerInstance) error {
+	if len(r.NetworkInterfaceList) == 0 {
+		return nil
+	}
+
+	networkInterfaceNoList := make([]string, 0, len(r.NetworkInterfaceList))
 	for _, ni := range r.NetworkInterfaceList {
-		networkInterface, err := getNetworkInterface(config, *ni.NetworkInterfaceNo)
+		networkInterfaceNoList = append(networkInterfaceNoList, *ni.NetworkInterfaceNo)
+	}
 
-		if err != nil {
-			return err
-		}
+	networkInterfaces, err := config.readNetworkInterfaceList(networkInterfaceNoList)
+	if err != nil {
+		return err
+	}
 
+	// The list is shared with the read cache, so the network interfaces are replaced rather than changed
+	networkInterfaceList := make([]*ServerInstanceNetworkInterface, 0, len(r.NetworkInterfaceList))
+	for _, ni := range r.NetworkInterfaceList {
+		networkInterface := networkInterfaces[*ni.NetworkInterfaceNo]
 		if networkInterface == nil {
+			networkInterfaceList = append(networkInterfaceList, ni)
 			continue
 		}
 
@@ -894,66 +1051,62 @@ func buildNetworkInterfaceList(config *ProviderConfig, r *ServerInstance) error
 			return fmt.Errorf("error parsing network interface device name: %s", *networkInterface.DeviceName)
 		}
 
-		ni.PrivateIp = networkInterface.Ip
-		ni.SubnetNo = networkInterface.SubnetNo
-		ni.NetworkInterfaceNo = networkInterface.NetworkInterfaceNo
-		ni.Order = ncloud.Int32(int32(order))
+		networkInterfaceList = append(networkInterfaceList, &ServerInstanceNetworkInterface{
+			PrivateIp:          networkInterface.Ip,
+			SubnetNo:           networkInterface.SubnetNo,
+			NetworkInterfaceNo: networkInterface.NetworkInterfaceNo,
+			Order:              ncloud.Int32(int32(order)),
+		})
 	}
+	r.NetworkInterfaceList = networkInterfaceList
 
 	return nil
 }
 
-func stopThenWaitServerInstance(config *ProviderConfig, id string) error {
+func stopThenWaitServerInstance(ctx context.Context, config *ProviderConfig, id string) error {
 	var err error
 
-	stateConf := &resource.StateChangeConf{
+	waiter := &Waiter[ServerInstance]{
 		Pending: []string{"SETUP"},
 		Target:  []string{"NULL"},
-		Refresh: func() (interface{}, string, error) {
-			instance, err := getServerInstance(config, id)
-			if err != nil {
-				return 0, "", err
-			}
-
-			return instance, ncloud.StringValue(instance.ServerInstanceOperation), nil
+		Name:    fmt.Sprintf("ServerInstance (%s)", id),
+		Refresh: func() (*ServerInstance, error) {
+			return config.ServerService.Get(id)
+		},
+		Status: func(instance *ServerInstance) string {
+			return ncloud.StringValue(instance.ServerInstanceOperation)
 		},
-		Timeout:    DefaultStopTimeout,
-		Delay:      2 * time.Second,
-		MinTimeout: 3 * time.Second,
+		Timeout: DefaultStopTimeout,
+		Delay:   2 * time.Second,
 	}
 
-	_, err = stateConf.WaitForState()
+	_, err = waiter.Wait(ctx)
 	if err != nil {
 		return fmt.Errorf("error waiting for ServerInstance operation to be \"NULL\": %s", err)
 	}
 
-	if config.SupportVPC {
-		err = stopVpcServerInstance(config, id)
-	} else {
-		err = stopClassicServerInstance(config, id)
-	}
-
-	if err != nil {
+	if err := config.ServerService.Stop(id); err != nil {
 		return err
 	}
 
-	stateConf = &resource.StateChangeConf{
+	waiter = &Waiter[ServerInstance]{
 		Pending: []string{"RUN"},
 		Target:  []string{"NSTOP"},
-		Refresh: func() (interface{}, string, error) {
-			instance, err := getServerInstance(config, id)
-			if err != nil {
-				return 0, "", err
-			}
-
-			return instance, ncloud.StringValue(instance.ServerInstanceStatus), nil
+		Name:    fmt.Sprintf("ServerInstance (%s)", id),
+		Refresh: func() (*ServerInstance, error) {
+			return config.ServerService.Get(id)
+		},
+		Status: func(instance *ServerInstance) string {
+			return ncloud.StringValue(instance.ServerInstanceStatus)
 		},
-		Timeout:    DefaultStopTimeout,
-		Delay:      2 * time.Second,
-		MinTimeout: 3 * time.Second,
+		Operation: func(instance *ServerInstance) string {
+			return ncloud.StringValue(instance.ServerInstanceOperation)
+		},
+		Timeout: DefaultStopTimeout,
+		Delay:   2 * time.Second,
 	}
 
-	_, err = stateConf.WaitForState()
+	_, err = waiter.Wait(ctx)
 	if err != nil {
 		return fmt.Errorf("error waiting for ServerInstance state to be \"NSTOP\": %s", err)
 	}
@@ -992,38 +1145,29 @@ func stopVpcServerInstance(config *ProviderConfig, id string) error {
 	return nil
 }
 
-func terminateThenWaitServerInstance(config *ProviderConfig, id string) error {
-	var err error
-	if config.SupportVPC {
-		err = terminateVpcServerInstance(config, id)
-	} else {
-		err = terminateClassicServerInstance(config, id)
-	}
-
-	if err != nil {
+func terminateThenWaitServerInstance(ctx context.Context, config *ProviderConfig, id string) error {
+	if err := config.ServerService.Terminate(ctx, id); err != nil {
 		return err
 	}
 
-	stateConf := &resource.StateChangeConf{
+	waiter := &Waiter[ServerInstance]{
 		Pending: []string{"NSTOP"},
 		Target:  []string{"TERMINATED"},
-		Refresh: func() (interface{}, string, error) {
-			instance, err := getServerInstance(config, id)
-
-			if err != nil {
-				return 0, "", err
-			}
-			if instance == nil { // Instance is terminated.
-				return instance, "TERMINATED", nil
-			}
-			return instance, ncloud.StringValue(instance.ServerInstanceStatus), nil
+		Name:    fmt.Sprintf("ServerInstance (%s)", id),
+		Refresh: func() (*ServerInstance, error) {
+			return config.ServerService.Get(id)
+		},
+		Status: func(instance *ServerInstance) string {
+			return ncloud.StringValue(instance.ServerInstanceStatus)
+		},
+		Operation: func(instance *ServerInstance) string {
+			return ncloud.StringValue(instance.ServerInstanceOperation)
 		},
-		Timeout:    DefaultTimeout,
-		Delay:      2 * time.Second,
-		MinTimeout: 3 * time.Second,
+		Timeout: DefaultTimeout,
+		Delay:   2 * time.Second,
 	}
 
-	_, err = stateConf.WaitForState()
+	_, err := waiter.Wait(ctx)
 	if err != nil {
 		return fmt.Errorf("error waiting for ServerInstance state to be \"TERMINATED\": %s", err)
 	}
@@ -1031,19 +1175,18 @@ func terminateThenWaitServerInstance(config *ProviderConfig, id string) error {
 	return nil
 }
 
-func terminateClassicServerInstance(config *ProviderConfig, id string) error {
+func terminateClassicServerInstance(ctx context.Context, config *ProviderConfig, id string) error {
 	reqParams := &server.TerminateServerInstancesRequest{
 		ServerInstanceNoList: []*string{ncloud.String(id)},
 	}
 
 	var resp *server.TerminateServerInstancesResponse
-	err := resource.Retry(1*time.Minute, func() *resource.RetryError {
+	err := resource.RetryContext(ctx, 1*time.Minute, func() *resource.RetryError {
 		var err error
 		logCommonRequest("terminateClassicServerInstance", reqParams)
 		resp, err = config.Client.server.V2Api.TerminateServerInstances(reqParams)
 		if err != nil {
-			errBody, _ := GetCommonErrorBody(err)
-			if containsInStringList(errBody.ReturnCode, []string{ApiErrorUnknown, ApiErrorServerObjectInOperation2}) {
+			if containsInStringList(apiErrorCode(err), []string{ApiErrorUnknown, ApiErrorServerObjectInOperation2}) {
 				logErrorResponse("retry terminateClassicServerInstance", err, reqParams)
 				return resource.RetryableError(err)
 			}
@@ -1079,14 +1222,6 @@ func terminateVpcServerInstance(config *ProviderConfig, id string) error {
 	return nil
 }
 
-func getAdditionalBlockStorageList(config *ProviderConfig, id string) ([]*BlockStorage, error) {
-	if config.SupportVPC {
-		return getVpcAdditionalBlockStorageList(config, id)
-	} else {
-		return getClassicAdditionalBlockStorageList(config, id)
-	}
-}
-
 func getVpcAdditionalBlockStorageList(config *ProviderConfig, id string) ([]*BlockStorage, error) {
 	resp, err := config.Client.vserver.V2Api.GetBlockStorageInstanceList(&vserver.GetBlockStorageInstanceListRequest{
 		RegionCode:               &config.RegionCode,
@@ -1170,42 +1305,9 @@ func convertClassicBlockStorage(storage *server.BlockStorageInstance) *BlockStor
 	}
 }
 
-func disconnectBlockStorage(config *ProviderConfig, storage *BlockStorage) error {
-	if config.SupportVPC {
-		return disconnectVpcBlockStorage(config, storage)
-	} else {
-		return disconnectClassicBlockStorage(config, storage)
-	}
-}
-
-func disconnectVpcBlockStorage(config *ProviderConfig, storage *BlockStorage) error {
-	_, err := config.Client.vserver.V2Api.DetachBlockStorageInstances(&vserver.DetachBlockStorageInstancesRequest{
-		RegionCode:                 &config.RegionCode,
-		BlockStorageInstanceNoList: []*string{storage.BlockStorageInstanceNo},
-	})
-
-	if err != nil {
-		return err
-	}
-
-	return nil
-}
-
-func disconnectClassicBlockStorage(config *ProviderConfig, storage *BlockStorage) error {
-	_, err := config.Client.server.V2Api.DetachBlockStorageInstances(&server.DetachBlockStorageInstancesRequest{
-		BlockStorageInstanceNoList: []*string{storage.BlockStorageInstanceNo},
-	})
-
-	if err != nil {
-		return err
-	}
-
-	return nil
-}
-
-func waitForDisconnectBlockStorage(config *ProviderConfig, d *schema.ResourceData, storage *BlockStorage) error {
-	return resource.Retry(d.Timeout(schema.TimeoutDelete), func() *resource.RetryError {
-		blockStorage, err := getBlockStorage(config, *storage.BlockStorageInstanceNo)
+func waitForDisconnectBlockStorage(ctx context.Context, config *ProviderConfig, d *schema.ResourceData, storage *BlockStorage) error {
+	return resource.RetryContext(ctx, d.Timeout(schema.TimeoutDelete), func() *resource.RetryError {
+		blockStorage, err := config.BlockStorageService.Get(*storage.BlockStorageInstanceNo)
 		if err != nil {
 			return resource.RetryableError(err)
 		}
@@ -1217,7 +1319,7 @@ func waitForDisconnectBlockStorage(config *ProviderConfig, d *schema.ResourceDat
 }
 
 func getServerZoneNo(config *ProviderConfig, serverInstanceNo string) (string, error) {
-	instance, err := getServerInstance(config, serverInstanceNo)
+	instance, err := config.ServerService.Get(serverInstanceNo)
 	if err != nil || instance == nil || instance.ZoneNo == nil {
 		return "", err
 	}
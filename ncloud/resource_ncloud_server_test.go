@@ -1,16 +1,71 @@
 package ncloud
 
 import (
+	"context"
+	"errors"
 	"fmt"
 	"regexp"
 	"testing"
+	"time"
 
+	"github.com/NaverCloudPlatform/ncloud-sdk-go-v2/ncloud"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 )
 
+// fakeServerService server service of the instance in memory. Methods not overridden panic.
+type fakeServerService struct {
+	ServerService
+	instance *ServerInstance
+}
+
+func (s *fakeServerService) Get(id string) (*ServerInstance, error) {
+	return s.instance, nil
+}
+
+func TestWaitStateNcloudServerForCreation_cancel(t *testing.T) {
+	config := &ProviderConfig{
+		ServerService: &fakeServerService{instance: &ServerInstance{ServerInstanceStatus: ncloud.String("INIT")}},
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	time.AfterFunc(100*time.Millisecond, cancel)
+
+	start := time.Now()
+	if err := waitStateNcloudServerForCreation(ctx, config, "1"); err == nil {
+		t.Fatal("Expected error for canceled context")
+	}
+
+	if elapsed := time.Since(start); elapsed > 10*time.Second {
+		t.Fatalf("Expected wait to stop on cancel, Actual: %s", elapsed)
+	}
+}
+
+func TestResourceNcloudServerCustomizeDiff_vpcTagList(t *testing.T) {
+	r := resourceNcloudServer()
+	raw := map[string]interface{}{
+		"server_image_product_code": "SW.VSVR.OS.LNX64.CNTOS.0708.B050",
+		"subnet_no":                 "1234",
+		"tag_list": []interface{}{
+			map[string]interface{}{"tag_key": "env", "tag_value": "prod"},
+		},
+	}
+
+	// The VPC server API has no tags, so tag_list is rejected at plan time rather than stored without being applied
+	_, err := r.Diff(context.Background(), nil, terraform.NewResourceConfigRaw(raw), &ProviderConfig{SupportVPC: true})
+	var argErr *ArgumentError
+	if !errors.As(err, &argErr) || argErr.Argument != "tag_list" {
+		t.Fatalf("Expected error of tag_list, Actual: %v", err)
+	}
+
+	delete(raw, "tag_list")
+	if _, err := r.Diff(context.Background(), nil, terraform.NewResourceConfigRaw(raw), &ProviderConfig{SupportVPC: true}); err != nil {
+		t.Fatalf("Unexpected error: %s", err)
+	}
+}
+
 func TestAccResourceNcloudServer_classic_basic(t *testing.T) {
 	var serverInstance ServerInstance
 	testServerName := getTestServerName()
@@ -69,6 +124,78 @@ func TestAccResourceNcloudServer_classic_basic(t *testing.T) {
 	})
 }
 
+func TestAccResourceNcloudServer_classic_defaultTags(t *testing.T) {
+	var serverInstance ServerInstance
+	testServerName := getTestServerName()
+	resourceName := "ncloud_server.server"
+	productCode := "SPSVRSTAND000004" // vCPU 2EA, Memory 4GB, Disk 50GB
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccClassicProviders,
+		CheckDestroy: func(state *terraform.State) error {
+			return testAccCheckInstanceDestroyWithProvider(state, testAccClassicProvider)
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testAccServerClassicDefaultTagsConfig(testServerName, productCode),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckServerExistsWithProvider(resourceName, &serverInstance, testAccClassicProvider),
+					resource.TestCheckResourceAttr(resourceName, "tag_list.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tag_list.0.tag_key", "env"),
+					resource.TestCheckResourceAttr(resourceName, "tag_list.0.tag_value", "test"),
+					resource.TestCheckResourceAttr(resourceName, "tag_list_all.#", "2"),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "tag_list_all.*", map[string]string{
+						"tag_key":   "env",
+						"tag_value": "test",
+					}),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "tag_list_all.*", map[string]string{
+						"tag_key":   "owner",
+						"tag_value": "terraform",
+					}),
+				),
+			},
+		},
+	})
+}
+
+func TestAccResourceNcloudServer_classic_updateTags(t *testing.T) {
+	var before, after ServerInstance
+	testServerName := getTestServerName()
+	resourceName := "ncloud_server.server"
+	productCode := "SPSVRSTAND000004" // vCPU 2EA, Memory 4GB, Disk 50GB
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccClassicProviders,
+		CheckDestroy: func(state *terraform.State) error {
+			return testAccCheckInstanceDestroyWithProvider(state, testAccClassicProvider)
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testAccServerClassicTagsConfig(testServerName, productCode, "test"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckServerExistsWithProvider(resourceName, &before, testAccClassicProvider),
+					resource.TestCheckResourceAttr(resourceName, "tag_list.0.tag_value", "test"),
+				),
+			},
+			{
+				Config: testAccServerClassicTagsConfig(testServerName, productCode, "prod"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckServerExistsWithProvider(resourceName, &after, testAccClassicProvider),
+					resource.TestCheckResourceAttr(resourceName, "tag_list.0.tag_value", "prod"),
+					func(*terraform.State) error {
+						if ncloud.StringValue(before.ServerInstanceNo) != ncloud.StringValue(after.ServerInstanceNo) {
+							return fmt.Errorf("server is recreated: %s -> %s", ncloud.StringValue(before.ServerInstanceNo), ncloud.StringValue(after.ServerInstanceNo))
+						}
+						return nil
+					},
+				),
+			},
+		},
+	})
+}
+
 func TestAccResourceNcloudServer_vpc_basic(t *testing.T) {
 	var serverInstance ServerInstance
 	testServerName := getTestServerName()
@@ -253,7 +380,7 @@ func testAccCheckServerExistsWithProvider(n string, i *ServerInstance, provider
 		}
 
 		config := provider.Meta().(*ProviderConfig)
-		instance, err := getServerInstance(config, rs.Primary.ID)
+		instance, err := config.ServerService.Get(rs.Primary.ID)
 		if err != nil {
 			return nil
 		}
@@ -287,7 +414,7 @@ func testAccCheckInstanceDestroyWithProvider(s *terraform.State, provider *schem
 		if rs.Type != "ncloud_server" {
 			continue
 		}
-		instance, err := getServerInstance(config, rs.Primary.ID)
+		instance, err := config.ServerService.Get(rs.Primary.ID)
 
 		if err != nil {
 			return err
@@ -400,6 +527,55 @@ resource "ncloud_server" "server" {
 `, testServerName, productCode)
 }
 
+func testAccServerClassicTagsConfig(testServerName, productCode, env string) string {
+	return fmt.Sprintf(`
+resource "ncloud_login_key" "loginkey" {
+	key_name = "%[1]s-key"
+}
+
+resource "ncloud_server" "server" {
+	name = "%[1]s"
+	server_image_product_code = "SPSW0LINUX000045"
+	server_product_code = "%[2]s"
+	login_key_name = "${ncloud_login_key.loginkey.key_name}"
+
+	tag_list {
+		tag_key = "env"
+		tag_value = "%[3]s"
+	}
+}
+`, testServerName, productCode, env)
+}
+
+func testAccServerClassicDefaultTagsConfig(testServerName, productCode string) string {
+	return fmt.Sprintf(`
+provider "ncloud" {
+	default_tags {
+		tags = {
+			owner = "terraform"
+			env   = "default"
+		}
+	}
+}
+
+resource "ncloud_login_key" "loginkey" {
+	key_name = "%[1]s-key"
+}
+
+resource "ncloud_server" "server" {
+	name = "%[1]s"
+	server_image_product_code = "SPSW0LINUX000045"
+	server_product_code = "%[2]s"
+	login_key_name = "${ncloud_login_key.loginkey.key_name}"
+
+	tag_list {
+		tag_key = "env"
+		tag_value = "test"
+	}
+}
+`, testServerName, productCode)
+}
+
 func testAccServerClassicConfig(testServerName, productCode string) string {
 	return fmt.Sprintf(`
 resource "ncloud_login_key" "loginkey" {
@@ -487,7 +487,7 @@ func resourceNcloudSourceBuildProjectCreate(ctx context.Context, d *schema.Resou
 	id, err := SourceBuildProjectCreate(d, config)
 
 	if err != nil {
-		return diag.FromErr(err)
+		return ToDiagnostics(err)
 	}
 
 	d.SetId(*ncloud.IntString(int(ncloud.Int32Value(id))))
@@ -534,7 +534,7 @@ func resourceNcloudSourceBuildProjectDelete(ctx context.Context, d *schema.Resou
 	err := config.Client.sourcebuild.V1Api.DeleteProject(ctx, id)
 	if err != nil {
 		logErrorResponse("deleteSourceBuildProject", err, id)
-		return diag.FromErr(err)
+		return ToDiagnostics(err)
 	}
 
 	d.SetId("")
@@ -546,7 +546,7 @@ func resourceNcloudSourceBuildProjectUpdate(ctx context.Context, d *schema.Resou
 
 	err := changeBuildProject(ctx, d, config)
 	if err != nil {
-		return diag.FromErr(err)
+		return ToDiagnostics(err)
 	}
 
 	return resourceNcloudSourceBuildProjectRead(ctx, d, meta)
@@ -761,7 +761,7 @@ func resourceNcloudSourceBuildProjectRead(ctx context.Context, d *schema.Resourc
 
 	project, err := getBuildProject(ctx, config, ncloud.String(d.Id()))
 	if err != nil {
-		return diag.FromErr(err)
+		return ToDiagnostics(err)
 	}
 	if project == nil {
 		d.SetId("")
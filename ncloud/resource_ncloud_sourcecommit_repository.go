@@ -107,7 +107,7 @@ func resourceNcloudSourceCommitRepositoryCreate(ctx context.Context, d *schema.R
 
 	if err := waitForSourceCommitRepositoryActive(ctx, d, config, name); err != nil {
 
-		diags := append(diag.FromErr(err), diag.Diagnostic{
+		diags := append(ToDiagnostics(err), diag.Diagnostic{
 			Severity: diag.Error,
 			Summary:  "Unable to search repository",
 			Detail:   fmt.Sprintf("Unable to search repository - detail , name : (%s)", name),
@@ -177,7 +177,7 @@ func resourceNcloudSourceCommitRepositoryUpdate(ctx context.Context, d *schema.R
 
 		if err != nil {
 			logErrorResponse("resourceNcloudSourceCommitRepositoryUpdate", err, *id)
-			return diag.FromErr(err)
+			return ToDiagnostics(err)
 		}
 
 		logResponse("resourceNcloudSourceCommitRepositoryUpdate", id)
@@ -195,7 +195,7 @@ func resourceNcloudSourceCommitRepositoryDelete(ctx context.Context, d *schema.R
 
 	if _, err := config.Client.sourcecommit.V1Api.DeleteRepository(ctx, id); err != nil {
 		logErrorResponse("resourceNcloudSourceCommitRepositoryDelete", err, *id)
-		return diag.FromErr(err)
+		return ToDiagnostics(err)
 	}
 
 	logResponse("resourceNcloudSourceCommitRepositoryDelete", id)
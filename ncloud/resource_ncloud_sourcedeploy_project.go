@@ -58,7 +58,7 @@ func resourceNcloudSourceDeployProjectCreate(ctx context.Context, d *schema.Reso
 	resp, err := config.Client.vsourcedeploy.V1Api.CreateProject(ctx, reqParams)
 	if err != nil {
 		logErrorResponse("CreateSourceDeployProject", err, reqParams)
-		return diag.FromErr(err)
+		return ToDiagnostics(err)
 	}
 	logResponse("CreateSourceDeployProject", resp)
 	d.SetId(*ncloud.IntString(int(ncloud.Int32Value(resp.Id))))
@@ -74,7 +74,7 @@ func resourceNcloudSourceDeployProjectRead(ctx context.Context, d *schema.Resour
 	}
 	project, err := getSourceDeployProjectById(ctx, config, d.Id())
 	if err != nil {
-		return diag.FromErr(err)
+		return ToDiagnostics(err)
 	}
 
 	if project == nil {
@@ -98,7 +98,7 @@ func resourceNcloudSourceDeployProjectDelete(ctx context.Context, d *schema.Reso
 	resp, err := config.Client.vsourcedeploy.V1Api.DeleteProject(ctx, ncloud.String(d.Id()))
 	if err != nil {
 		logErrorResponse("DeleteSourceDeployProject", err, d.Id())
-		return diag.FromErr(err)
+		return ToDiagnostics(err)
 	}
 
 	logResponse("DeleteSourceDeployProject", resp)
@@ -131,7 +131,7 @@ func resourceNcloudSourceDeployStageCreate(ctx context.Context, d *schema.Resour
 	resp, err := config.Client.vsourcedeploy.V1Api.CreateStage(ctx, reqParams, projectId)
 	if err != nil {
 		logErrorResponse("createSourceDeployStage", err, reqParams)
-		return diag.FromErr(err)
+		return ToDiagnostics(err)
 	}
 	logResponse("createSourceDeployStage", resp.Id)
 
@@ -151,7 +151,7 @@ func resourceNcloudSourceDeployStageRead(ctx context.Context, d *schema.Resource
 	stage, err := getSourceDeployStageById(ctx, config, projectId, ncloud.String(d.Id()))
 
 	if err != nil {
-		return diag.FromErr(err)
+		return ToDiagnostics(err)
 	}
 
 	if stage == nil {
@@ -172,7 +172,7 @@ func resourceNcloudSourceDeployStageUpdate(ctx context.Context, d *schema.Resour
 
 	err := changeDeployStage(ctx, d, config)
 	if err != nil {
-		return diag.FromErr(err)
+		return ToDiagnostics(err)
 	}
 
 	return resourceNcloudSourceDeployStageRead(ctx, d, meta)
@@ -189,7 +189,7 @@ func resourceNcloudSourceDeployStageDelete(ctx context.Context, d *schema.Resour
 	resp, err := config.Client.vsourcedeploy.V1Api.DeleteStage(ctx, projectId, ncloud.String(d.Id()))
 	if err != nil {
 		logErrorResponse("deleteSourceDeployStage", err, d.Id())
-		return diag.FromErr(err)
+		return ToDiagnostics(err)
 	}
 
 	logResponse("deleteSourceDeployStage", resp)
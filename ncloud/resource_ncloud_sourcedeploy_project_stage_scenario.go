@@ -451,7 +451,7 @@ func resourceNcloudSourceDeployScenarioRead(ctx context.Context, d *schema.Resou
 	scenario, err := getSourceDeployScenarioById(ctx, config, projectId, stageId, ncloud.String(d.Id()))
 
 	if err != nil {
-		return diag.FromErr(err)
+		return ToDiagnostics(err)
 	}
 
 	if scenario == nil {
@@ -843,7 +843,7 @@ func resourceNcloudSourceDeployScenarioDelete(ctx context.Context, d *schema.Res
 	resp, err := config.Client.vsourcedeploy.V1Api.DeleteScenario(ctx, projectId, stageId, ncloud.String(d.Id()))
 	if err != nil {
 		logErrorResponse("deleteSourceDeployScenario", err, d.Id())
-		return diag.FromErr(err)
+		return ToDiagnostics(err)
 	}
 	logResponse("deleteSourceDeployScenario", resp)
 	d.SetId("")
@@ -855,7 +855,7 @@ func resourceNcloudSourceDeployScenarioUpdate(ctx context.Context, d *schema.Res
 
 	err := changeDeployScenario(ctx, d, config)
 	if err != nil {
-		return diag.FromErr(err)
+		return ToDiagnostics(err)
 	}
 
 	return resourceNcloudSourceDeployScenarioRead(ctx, d, meta)
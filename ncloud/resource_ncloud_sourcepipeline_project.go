@@ -186,7 +186,7 @@ func resourceNcloudSourcePipelineRead(ctx context.Context, d *schema.ResourceDat
 
 	pipelineProject, err := getPipelineProject(ctx, config, d.Id())
 	if err != nil {
-		return diag.FromErr(err)
+		return ToDiagnostics(err)
 	}
 	if pipelineProject == nil {
 		d.SetId("")
@@ -224,7 +224,7 @@ func resourceNcloudSourcePipelineDelete(ctx context.Context, d *schema.ResourceD
 
 	err := deletePipelineProject(ctx, config, d.Id())
 	if err != nil {
-		return diag.FromErr(err)
+		return ToDiagnostics(err)
 	}
 
 	d.SetId("")
@@ -254,7 +254,7 @@ func createClassicPipelineProject(d *schema.ResourceData, config *ProviderConfig
 	resp, err := config.Client.sourcepipeline.V1Api.CreateProject(context.Background(), reqParams)
 	if err != nil {
 		logErrorResponse("createSourcePipelineProject", err, reqParams)
-		return nil, diag.FromErr(err)
+		return nil, ToDiagnostics(err)
 	}
 	logResponse("createSourcePipelineProject", resp)
 
@@ -277,7 +277,7 @@ func createVpcPipelineProject(d *schema.ResourceData, config *ProviderConfig) (*
 	resp, err := config.Client.vsourcepipeline.V1Api.CreateProject(context.Background(), reqParams)
 	if err != nil {
 		logErrorResponse("createSourcePipelineProject", err, reqParams)
-		return nil, diag.FromErr(err)
+		return nil, ToDiagnostics(err)
 	}
 	logResponse("createSourcePipelineProject", resp)
 
@@ -341,7 +341,7 @@ func updateClassicPipelineProject(ctx context.Context, d *schema.ResourceData, c
 	resp, err := config.Client.sourcepipeline.V1Api.ChangeProject(ctx, reqParams, &projectId)
 	if err != nil {
 		logErrorResponse("setSourcePipelineProject", err, projectId)
-		return diag.FromErr(err)
+		return ToDiagnostics(err)
 	}
 	logResponse("setSourcePipelineProject", resp)
 
@@ -367,7 +367,7 @@ func updateVpcPipelineProject(ctx context.Context, d *schema.ResourceData, confi
 	resp, err := config.Client.vsourcepipeline.V1Api.ChangeProject(ctx, reqParams, &projectId)
 	if err != nil {
 		logErrorResponse("setSourcePipelineProject", err, projectId)
-		return diag.FromErr(err)
+		return ToDiagnostics(err)
 	}
 	logResponse("setSourcePipelineProject", resp)
 
@@ -557,7 +557,7 @@ func makeTaskData(config *ProviderConfig, tasks []*PipelineTask) ([]map[string]i
 				}
 				taskConfig, err := makeDeployTaskConfig(task.Config)
 				if err != nil {
-					return nil, diag.FromErr(err)
+					return nil, ToDiagnostics(err)
 				}
 				mapping := map[string]interface{}{
 					"name":         ncloud.StringValue(task.Name),
@@ -1,6 +1,7 @@
 package ncloud
 
 import (
+	"context"
 	"errors"
 	"fmt"
 	"regexp"
@@ -256,7 +257,7 @@ func testAccCheckSubnetDisappears(instance *vpc.Subnet) resource.TestCheckFunc {
 
 		_, err := config.Client.vpc.V2Api.DeleteSubnet(reqParams)
 
-		if err := waitForNcloudSubnetDeletion(config, *instance.SubnetNo); err != nil {
+		if err := waitForNcloudSubnetDeletion(context.Background(), config, *instance.SubnetNo); err != nil {
 			return err
 		}
 
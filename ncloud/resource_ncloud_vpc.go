@@ -1,6 +1,7 @@
 package ncloud
 
 import (
+	"context"
 	"fmt"
 	"github.com/NaverCloudPlatform/ncloud-sdk-go-v2/services/vserver"
 	"log"
@@ -8,7 +9,7 @@ import (
 
 	"github.com/NaverCloudPlatform/ncloud-sdk-go-v2/ncloud"
 	"github.com/NaverCloudPlatform/ncloud-sdk-go-v2/services/vpc"
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 )
@@ -19,11 +20,11 @@ func init() {
 
 func resourceNcloudVpc() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceNcloudVpcCreate,
-		Read:   resourceNcloudVpcRead,
-		Delete: resourceNcloudVpcDelete,
+		CreateContext: resourceNcloudVpcCreate,
+		ReadContext:   resourceNcloudVpcRead,
+		DeleteContext: resourceNcloudVpcDelete,
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
 		},
 		Schema: map[string]*schema.Schema{
 			"name": {
@@ -65,11 +66,11 @@ func resourceNcloudVpc() *schema.Resource {
 	}
 }
 
-func resourceNcloudVpcCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceNcloudVpcCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	config := meta.(*ProviderConfig)
 
 	if !config.SupportVPC {
-		return NotSupportClassic("resource `ncloud_vpc`")
+		return diag.FromErr(NotSupportClassic("resource `ncloud_vpc`"))
 	}
 
 	reqParams := &vpc.CreateVpcRequest{
@@ -85,7 +86,7 @@ func resourceNcloudVpcCreate(d *schema.ResourceData, meta interface{}) error {
 	resp, err := config.Client.vpc.V2Api.CreateVpc(reqParams)
 	if err != nil {
 		logErrorResponse("Create Vpc Instance", err, reqParams)
-		return err
+		return ToDiagnostics(err)
 	}
 
 	logCommonResponse("CreateVpc", GetCommonResponse(resp))
@@ -94,19 +95,19 @@ func resourceNcloudVpcCreate(d *schema.ResourceData, meta interface{}) error {
 	d.SetId(*vpcInstance.VpcNo)
 	log.Printf("[INFO] VPC ID: %s", d.Id())
 
-	if err := waitForNcloudVpcCreation(config, d.Id()); err != nil {
-		return err
+	if err := waitForNcloudVpcCreation(ctx, config, d.Id()); err != nil {
+		return ToDiagnostics(err)
 	}
 
-	return resourceNcloudVpcRead(d, meta)
+	return resourceNcloudVpcRead(ctx, d, meta)
 }
 
-func resourceNcloudVpcRead(d *schema.ResourceData, meta interface{}) error {
+func resourceNcloudVpcRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	config := meta.(*ProviderConfig)
 
 	instance, err := getVpcInstance(config, d.Id())
 	if err != nil {
-		return err
+		return ToDiagnostics(err)
 	}
 
 	if instance == nil {
@@ -122,20 +123,20 @@ func resourceNcloudVpcRead(d *schema.ResourceData, meta interface{}) error {
 	if *instance.VpcStatus.Code != "TERMTING" {
 		defaultNetworkACLNo, err := getDefaultNetworkACL(config, d.Id())
 		if err != nil {
-			return fmt.Errorf("error get default network acl for VPC (%s): %s", d.Id(), err)
+			return diag.FromErr(fmt.Errorf("error get default network acl for VPC (%s): %s", d.Id(), err))
 		}
 
 		d.Set("default_network_acl_no", defaultNetworkACLNo)
 
 		defaultAcgNo, err := getDefaultAccessControlGroup(config, d.Id())
 		if err != nil {
-			return fmt.Errorf("error get default Access Control Group for VPC (%s): %s", d.Id(), err)
+			return diag.FromErr(fmt.Errorf("error get default Access Control Group for VPC (%s): %s", d.Id(), err))
 		}
 		d.Set("default_access_control_group_no", defaultAcgNo)
 
 		publicRouteTableNo, privateRouteTableNo, err := getDefaultRouteTable(config, d.Id())
 		if err != nil {
-			return fmt.Errorf("error get default Route Table for VPC (%s): %s", d.Id(), err)
+			return diag.FromErr(fmt.Errorf("error get default Route Table for VPC (%s): %s", d.Id(), err))
 		}
 		d.Set("default_public_route_table_no", publicRouteTableNo)
 		d.Set("default_private_route_table_no", privateRouteTableNo)
@@ -229,7 +230,7 @@ func getDefaultRouteTable(config *ProviderConfig, id string) (publicRouteTableNo
 	return publicRouteTableNo, privateRouteTableNo, nil
 }
 
-func resourceNcloudVpcDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceNcloudVpcDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	config := meta.(*ProviderConfig)
 
 	reqParams := &vpc.DeleteVpcRequest{
@@ -241,51 +242,53 @@ func resourceNcloudVpcDelete(d *schema.ResourceData, meta interface{}) error {
 	resp, err := config.Client.vpc.V2Api.DeleteVpc(reqParams)
 	if err != nil {
 		logErrorResponse("DeleteVpc Vpc Instance", err, reqParams)
-		return err
+		return ToDiagnostics(err)
 	}
 	logResponse("DeleteVpc", resp)
 
-	if err := waitForNcloudVpcDeletion(config, d.Id()); err != nil {
-		return err
+	if err := waitForNcloudVpcDeletion(ctx, config, d.Id()); err != nil {
+		return ToDiagnostics(err)
 	}
 
 	return nil
 }
 
-func waitForNcloudVpcCreation(config *ProviderConfig, id string) error {
-	stateConf := &resource.StateChangeConf{
+func waitForNcloudVpcCreation(ctx context.Context, config *ProviderConfig, id string) error {
+	waiter := &Waiter[vpc.Vpc]{
 		Pending: []string{"INIT", "CREATING"},
 		Target:  []string{"RUN"},
-		Refresh: func() (interface{}, string, error) {
-			instance, err := getVpcInstance(config, id)
-			return VpcCommonStateRefreshFunc(instance, err, "VpcStatus")
+		Refresh: func() (*vpc.Vpc, error) {
+			return getVpcInstance(config, id)
 		},
-		Timeout:    DefaultCreateTimeout,
-		Delay:      2 * time.Second,
-		MinTimeout: 3 * time.Second,
+		Status: func(instance *vpc.Vpc) string {
+			return commonCodeValue(instance.VpcStatus)
+		},
+		Timeout: DefaultCreateTimeout,
+		Delay:   2 * time.Second,
 	}
 
-	if _, err := stateConf.WaitForState(); err != nil {
+	if _, err := waiter.Wait(ctx); err != nil {
 		return fmt.Errorf("Error waiting for VPC (%s) to become available: %s", id, err)
 	}
 
 	return nil
 }
 
-func waitForNcloudVpcDeletion(config *ProviderConfig, id string) error {
-	stateConf := &resource.StateChangeConf{
+func waitForNcloudVpcDeletion(ctx context.Context, config *ProviderConfig, id string) error {
+	waiter := &Waiter[vpc.Vpc]{
 		Pending: []string{"RUN", "TERMTING"},
 		Target:  []string{"TERMINATED"},
-		Refresh: func() (interface{}, string, error) {
-			instance, err := getVpcInstance(config, id)
-			return VpcCommonStateRefreshFunc(instance, err, "VpcStatus")
+		Refresh: func() (*vpc.Vpc, error) {
+			return getVpcInstance(config, id)
+		},
+		Status: func(instance *vpc.Vpc) string {
+			return commonCodeValue(instance.VpcStatus)
 		},
-		Timeout:    DefaultTimeout,
-		Delay:      2 * time.Second,
-		MinTimeout: 3 * time.Second,
+		Timeout: DefaultTimeout,
+		Delay:   2 * time.Second,
 	}
 
-	if _, err := stateConf.WaitForState(); err != nil {
+	if _, err := waiter.Wait(ctx); err != nil {
 		return fmt.Errorf("Error waiting for VPC (%s) to become termintaing: %s", id, err)
 	}
 
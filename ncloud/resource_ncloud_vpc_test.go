@@ -1,6 +1,7 @@
 package ncloud
 
 import (
+	"context"
 	"errors"
 	"fmt"
 	"math/rand"
@@ -153,7 +154,7 @@ func testAccCheckVpcDisappears(instance *vpc.Vpc) resource.TestCheckFunc {
 
 		_, err := config.Client.vpc.V2Api.DeleteVpc(reqParams)
 
-		if err := waitForNcloudVpcDeletion(config, *instance.VpcNo); err != nil {
+		if err := waitForNcloudVpcDeletion(context.Background(), config, *instance.VpcNo); err != nil {
 			return err
 		}
 
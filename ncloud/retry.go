@@ -0,0 +1,139 @@
+package ncloud
+
+import (
+	"bytes"
+	"io/ioutil"
+	"log"
+	"math/rand"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+)
+
+const (
+	DefaultMaxRetries = 5
+
+	DefaultRetryMinDelay = 1 * time.Second
+	DefaultRetryMaxDelay = 30 * time.Second
+)
+
+// retryableApiErrorCodes error codes returned while the target is in operation or changed by another request at the same time
+var retryableApiErrorCodes = []string{
+	ApiErrorObjectInOperation,
+	ApiErrorPortForwardingObjectInOperation,
+	ApiErrorServerObjectInOperation,
+	ApiErrorServerObjectInOperation2,
+	ApiErrorPreviousServersHaveNotBeenEntirelyTerminated,
+	ApiErrorAcgCantChangeSameTime,
+	ApiErrorNetworkAclCantAccessaApropriate,
+	ApiErrorNetworkAclRuleChangeIngRules,
+	ApiErrorASGScalingIsActive,
+}
+
+// retryTransport retries API calls rejected with HTTP 429 or a retryable error code with exponential backoff and jitter.
+// HTTP 5xx is retried for reads only, as the backend may have accepted a create or other change before failing.
+type retryTransport struct {
+	transport  http.RoundTripper
+	maxRetries int
+	minDelay   time.Duration
+	maxDelay   time.Duration
+}
+
+func newRetryTransport(transport http.RoundTripper, maxRetries int) *retryTransport {
+	return &retryTransport{
+		transport:  transport,
+		maxRetries: maxRetries,
+		minDelay:   DefaultRetryMinDelay,
+		maxDelay:   DefaultRetryMaxDelay,
+	}
+}
+
+func (t *retryTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	r := req
+	for attempt := 0; ; attempt++ {
+		resp, err := t.transport.RoundTrip(r)
+		if err != nil || attempt >= t.maxRetries {
+			return resp, err
+		}
+
+		reason, retryable := isRetryableResponse(req, resp)
+		if !retryable || (req.Body != nil && req.GetBody == nil) {
+			return resp, nil
+		}
+		resp.Body.Close()
+
+		delay := t.backoff(attempt, resp)
+		log.Printf("[DEBUG] retrying %s %s in %s (%d/%d): %s", req.Method, req.URL.Path, delay, attempt+1, t.maxRetries, reason)
+
+		select {
+		case <-req.Context().Done():
+			return nil, req.Context().Err()
+		case <-time.After(delay):
+		}
+
+		r = req.Clone(req.Context())
+		if req.GetBody != nil {
+			if r.Body, err = req.GetBody(); err != nil {
+				return nil, err
+			}
+		}
+	}
+}
+
+// backoff returns exponential delay of the attempt with jitter, or Retry-After of the response if set
+func (t *retryTransport) backoff(attempt int, resp *http.Response) time.Duration {
+	if seconds, err := strconv.Atoi(resp.Header.Get("Retry-After")); err == nil && seconds > 0 {
+		if delay := time.Duration(seconds) * time.Second; delay < t.maxDelay {
+			return delay
+		}
+		return t.maxDelay
+	}
+
+	delay := t.maxDelay
+	if attempt < 16 && t.minDelay<<uint(attempt) < t.maxDelay {
+		delay = t.minDelay << uint(attempt)
+	}
+
+	return delay/2 + time.Duration(rand.Int63n(int64(delay/2)+1))
+}
+
+// isRetryableResponse returns whether the response of the request is retryable and the reason.
+// The body of error responses is read to find the return code and restored for the SDK.
+func isRetryableResponse(req *http.Request, resp *http.Response) (string, bool) {
+	if resp.StatusCode == http.StatusTooManyRequests {
+		return resp.Status, true
+	}
+
+	if resp.StatusCode >= http.StatusInternalServerError {
+		return resp.Status, isReadRequest(req)
+	}
+
+	if resp.StatusCode < http.StatusBadRequest {
+		return "", false
+	}
+
+	body, err := ioutil.ReadAll(resp.Body)
+	resp.Body.Close()
+	resp.Body = ioutil.NopCloser(bytes.NewReader(body))
+	if err != nil {
+		return "", false
+	}
+
+	apiErr := newAPIError(resp.StatusCode, body)
+	if apiErr != nil && containsInStringList(apiErr.ReturnCode, retryableApiErrorCodes) {
+		return apiErr.Error(), true
+	}
+
+	return "", false
+}
+
+// isReadRequest returns whether the API call only reads, i.e. a GET of the REST APIs or a `get` action of the others
+func isReadRequest(req *http.Request) bool {
+	if req.Method == http.MethodGet || req.Method == http.MethodHead {
+		return true
+	}
+
+	action := req.URL.Path[strings.LastIndex(req.URL.Path, "/")+1:]
+	return strings.HasPrefix(action, "get")
+}
@@ -0,0 +1,186 @@
+package ncloud
+
+import (
+	"context"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func testRetryClient(maxRetries int) *http.Client {
+	transport := newRetryTransport(http.DefaultTransport, maxRetries)
+	transport.minDelay = time.Millisecond
+	transport.maxDelay = 10 * time.Millisecond
+	return &http.Client{Transport: transport}
+}
+
+// testFlakyServer responds with status and body for the first n calls, then succeeds
+func testFlakyServer(t *testing.T, n int32, status int, body string, calls *int32) *httptest.Server {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		reqBody, _ := ioutil.ReadAll(r.Body)
+		if string(reqBody) != "regionCode=KR" {
+			t.Errorf("Expected request body to be sent on every attempt, Actual: %q", reqBody)
+		}
+
+		if atomic.AddInt32(calls, 1) <= n {
+			w.WriteHeader(status)
+			w.Write([]byte(body))
+			return
+		}
+		w.Write([]byte(`{"ok": true}`))
+	}))
+	t.Cleanup(ts.Close)
+	return ts
+}
+
+func TestRetryTransport_retryable(t *testing.T) {
+	cases := map[string]struct {
+		status int
+		body   string
+	}{
+		"429":           {http.StatusTooManyRequests, ""},
+		"503":           {http.StatusServiceUnavailable, ""},
+		"in operation":  {http.StatusBadRequest, `{"responseError": {"returnCode": "25013", "returnMessage": "in operation"}}`},
+		"acg same time": {http.StatusBadRequest, `{"responseError": {"returnCode": "1007009", "returnMessage": "can't change at the same time"}}`},
+	}
+
+	for name, tc := range cases {
+		var calls int32
+		ts := testFlakyServer(t, 2, tc.status, tc.body, &calls)
+
+		resp, err := testRetryClient(3).Post(ts.URL+"/getServerInstanceList", "application/x-www-form-urlencoded", strings.NewReader("regionCode=KR"))
+		if err != nil {
+			t.Fatalf("%s: Unexpected error: %s", name, err)
+		}
+		resp.Body.Close()
+
+		if resp.StatusCode != http.StatusOK || calls != 3 {
+			t.Fatalf("%s: Expected 200 after 3 calls, Actual: %d after %d calls", name, resp.StatusCode, calls)
+		}
+	}
+}
+
+func TestRetryTransport_notRetryable(t *testing.T) {
+	var calls int32
+	body := `{"responseError": {"returnCode": "1000", "returnMessage": "invalid parameter"}}`
+	ts := testFlakyServer(t, 1, http.StatusBadRequest, body, &calls)
+
+	resp, err := testRetryClient(3).Post(ts.URL, "application/x-www-form-urlencoded", strings.NewReader("regionCode=KR"))
+	if err != nil {
+		t.Fatalf("Unexpected error: %s", err)
+	}
+	defer resp.Body.Close()
+
+	if calls != 1 {
+		t.Fatalf("Expected 1 call, Actual: %d", calls)
+	}
+
+	// body is restored for the SDK to build the error
+	if respBody, _ := ioutil.ReadAll(resp.Body); string(respBody) != body {
+		t.Fatalf("Expected body: %s, Actual: %s", body, respBody)
+	}
+}
+
+func TestRetryTransport_mutation(t *testing.T) {
+	cases := map[string]struct {
+		status int
+		body   string
+		calls  int32
+	}{
+		// The create may have been accepted before the error, so it is not replayed
+		"503":          {http.StatusServiceUnavailable, "", 1},
+		"429":          {http.StatusTooManyRequests, "", 3},
+		"in operation": {http.StatusBadRequest, `{"responseError": {"returnCode": "25013", "returnMessage": "in operation"}}`, 3},
+	}
+
+	for name, tc := range cases {
+		var calls int32
+		ts := testFlakyServer(t, 2, tc.status, tc.body, &calls)
+
+		resp, err := testRetryClient(3).Post(ts.URL+"/createServerInstances", "application/x-www-form-urlencoded", strings.NewReader("regionCode=KR"))
+		if err != nil {
+			t.Fatalf("%s: Unexpected error: %s", name, err)
+		}
+		resp.Body.Close()
+
+		if calls != tc.calls {
+			t.Fatalf("%s: Expected %d calls, Actual: %d", name, tc.calls, calls)
+		}
+	}
+}
+
+func TestRetryTransport_maxRetries(t *testing.T) {
+	var calls int32
+	ts := testFlakyServer(t, 10, http.StatusBadGateway, "", &calls)
+
+	resp, err := testRetryClient(2).Post(ts.URL+"/getServerInstanceList", "application/x-www-form-urlencoded", strings.NewReader("regionCode=KR"))
+	if err != nil {
+		t.Fatalf("Unexpected error: %s", err)
+	}
+	resp.Body.Close()
+
+	if resp.StatusCode != http.StatusBadGateway || calls != 3 {
+		t.Fatalf("Expected 502 after 3 calls, Actual: %d after %d calls", resp.StatusCode, calls)
+	}
+}
+
+func TestRetryTransport_contextCanceled(t *testing.T) {
+	var calls int32
+	ts := testFlakyServer(t, 10, http.StatusServiceUnavailable, "", &calls)
+
+	transport := newRetryTransport(http.DefaultTransport, 5)
+	transport.minDelay = time.Minute
+	transport.maxDelay = time.Minute
+
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+
+	req, _ := http.NewRequestWithContext(ctx, http.MethodPost, ts.URL+"/getServerInstanceList", strings.NewReader("regionCode=KR"))
+	if _, err := (&http.Client{Transport: transport}).Do(req); err == nil {
+		t.Fatal("Expected error for canceled context")
+	}
+
+	if calls != 1 {
+		t.Fatalf("Expected 1 call, Actual: %d", calls)
+	}
+}
+
+func TestRetryTransport_backoff(t *testing.T) {
+	transport := newRetryTransport(http.DefaultTransport, 5)
+	resp := &http.Response{Header: http.Header{}}
+
+	for attempt, expected := range []time.Duration{time.Second, 2 * time.Second, 4 * time.Second, 8 * time.Second, 16 * time.Second, 30 * time.Second, 30 * time.Second} {
+		if delay := transport.backoff(attempt, resp); delay < expected/2 || delay > expected {
+			t.Fatalf("Expected delay of attempt %d between %s and %s, Actual: %s", attempt, expected/2, expected, delay)
+		}
+	}
+
+	resp.Header.Set("Retry-After", "3")
+	if delay := transport.backoff(0, resp); delay != 3*time.Second {
+		t.Fatalf("Expected Retry-After delay: 3s, Actual: %s", delay)
+	}
+}
+
+func TestIsReadRequest(t *testing.T) {
+	cases := []struct {
+		method   string
+		url      string
+		expected bool
+	}{
+		{http.MethodPost, "https://ncloud.apigw.ntruss.com/vserver/v2/getServerInstanceList", true},
+		{http.MethodPost, "https://ncloud.apigw.ntruss.com/vserver/v2/stopServerInstances", false},
+		{http.MethodGet, "https://nks.apigw.ntruss.com/vnks/v2/clusters/uuid", true},
+		{http.MethodPatch, "https://nks.apigw.ntruss.com/vnks/v2/clusters/uuid/lb-subnet", false},
+	}
+
+	for _, tc := range cases {
+		req, _ := http.NewRequest(tc.method, tc.url, nil)
+		if actual := isReadRequest(req); actual != tc.expected {
+			t.Fatalf("%s %s Expected: %t, Actual: %t", tc.method, tc.url, tc.expected, actual)
+		}
+	}
+}
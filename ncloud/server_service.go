@@ -0,0 +1,94 @@
+package ncloud
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// ServerService server API of the platform selected by `support_vpc` of the provider
+type ServerService interface {
+	// Create requests creation of the server from the resource data, as the parameters differ by platform
+	Create(ctx context.Context, d *schema.ResourceData) (*string, error)
+	// Get returns nil if the server is not found
+	Get(id string) (*ServerInstance, error)
+	Start(id string) error
+	Stop(id string) error
+	Terminate(ctx context.Context, id string) error
+	ChangeSpec(id string, serverProductCode string) error
+	SetProtectServerTermination(id string, protect bool) error
+	UpdateTags(d *schema.ResourceData) error
+}
+
+type classicServerService struct {
+	config *ProviderConfig
+}
+
+func (s *classicServerService) Create(ctx context.Context, d *schema.ResourceData) (*string, error) {
+	return createClassicServerInstance(ctx, d, s.config)
+}
+
+func (s *classicServerService) Get(id string) (*ServerInstance, error) {
+	return getClassicServerInstance(s.config, id)
+}
+
+func (s *classicServerService) Start(id string) error {
+	return startClassicServerInstance(s.config, id)
+}
+
+func (s *classicServerService) Stop(id string) error {
+	return stopClassicServerInstance(s.config, id)
+}
+
+func (s *classicServerService) Terminate(ctx context.Context, id string) error {
+	return terminateClassicServerInstance(ctx, s.config, id)
+}
+
+func (s *classicServerService) ChangeSpec(id string, serverProductCode string) error {
+	return changeClassicServerInstanceSpec(s.config, id, serverProductCode)
+}
+
+func (s *classicServerService) SetProtectServerTermination(id string, protect bool) error {
+	return updateClassicServerProtectionTermination(s.config, id, protect)
+}
+
+func (s *classicServerService) UpdateTags(d *schema.ResourceData) error {
+	return updateClassicServerInstanceTags(d, s.config)
+}
+
+type vpcServerService struct {
+	config *ProviderConfig
+}
+
+func (s *vpcServerService) Create(ctx context.Context, d *schema.ResourceData) (*string, error) {
+	return createVpcServerInstance(ctx, d, s.config)
+}
+
+func (s *vpcServerService) Get(id string) (*ServerInstance, error) {
+	return getVpcServerInstance(s.config, id)
+}
+
+func (s *vpcServerService) Start(id string) error {
+	return startVpcServerInstance(s.config, id)
+}
+
+func (s *vpcServerService) Stop(id string) error {
+	return stopVpcServerInstance(s.config, id)
+}
+
+func (s *vpcServerService) Terminate(_ context.Context, id string) error {
+	return terminateVpcServerInstance(s.config, id)
+}
+
+func (s *vpcServerService) ChangeSpec(id string, serverProductCode string) error {
+	return changeVpcServerInstanceSpec(s.config, id, serverProductCode)
+}
+
+func (s *vpcServerService) SetProtectServerTermination(id string, protect bool) error {
+	return updateVpcServerProtectionTermination(s.config, id, protect)
+}
+
+// UpdateTags fails, as the VPC server API has no tags. resourceNcloudServerCustomizeDiff rejects tag_list on VPC at plan time.
+func (s *vpcServerService) UpdateTags(d *schema.ResourceData) error {
+	return NotSupportVpc("argument `tag_list` of resource `ncloud_server`")
+}
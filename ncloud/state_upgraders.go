@@ -0,0 +1,85 @@
+package ncloud
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// stateUpgradeStep changes the raw state of a resource in place
+type stateUpgradeStep func(rawState map[string]interface{}) error
+
+// priorResource returns the resource with the schema of the previous version.
+// The schema is the one of the resource with the attributes changed since the previous version replaced;
+// a nil attribute marks one added since then.
+func priorResource(r *schema.Resource, changed map[string]*schema.Schema) *schema.Resource {
+	s := make(map[string]*schema.Schema, len(r.Schema)+len(changed))
+	for k, v := range r.Schema {
+		s[k] = v
+	}
+
+	for k, v := range changed {
+		if v == nil {
+			delete(s, k)
+			continue
+		}
+		s[k] = v
+	}
+
+	return &schema.Resource{
+		Schema:   s,
+		Timeouts: r.Timeouts,
+	}
+}
+
+// stateUpgrader returns the upgrader of the state of the version to the next one, running the steps in order.
+// The prior resource is used to decode the state of the version, see priorResource.
+func stateUpgrader(version int, prior *schema.Resource, steps ...stateUpgradeStep) schema.StateUpgrader {
+	return schema.StateUpgrader{
+		Version: version,
+		Type:    prior.CoreConfigSchema().ImpliedType(),
+		Upgrade: func(ctx context.Context, rawState map[string]interface{}, meta interface{}) (map[string]interface{}, error) {
+			if rawState == nil {
+				rawState = map[string]interface{}{}
+			}
+
+			for _, step := range steps {
+				if err := step(rawState); err != nil {
+					return nil, fmt.Errorf("error upgrading state from version %d: %s", version, err)
+				}
+			}
+
+			return rawState, nil
+		},
+	}
+}
+
+// clearStateAttributes clears the attributes kept in the schema only for compatibility with existing configurations
+func clearStateAttributes(keys ...string) stateUpgradeStep {
+	return func(rawState map[string]interface{}) error {
+		for _, k := range keys {
+			if _, ok := rawState[k]; ok {
+				rawState[k] = nil
+			}
+		}
+		return nil
+	}
+}
+
+// setStateId sets the ID to the value of the attribute, for the resources of which the ID format changed
+func setStateId(key string) stateUpgradeStep {
+	return func(rawState map[string]interface{}) error {
+		id, ok := rawState[key].(string)
+		if !ok || id == "" {
+			return fmt.Errorf("%s is not set", key)
+		}
+		rawState["id"] = id
+		return nil
+	}
+}
+
+// suppressDeprecatedDiff suppresses changes of the deprecated attributes no longer used, so that they never update or replace the resource
+func suppressDeprecatedDiff(_, _, _ string, _ *schema.ResourceData) bool {
+	return true
+}
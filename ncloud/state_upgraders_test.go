@@ -0,0 +1,144 @@
+package ncloud
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+
+	ctyjson "github.com/hashicorp/go-cty/cty/json"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// testStateFixture returns the state of the resource type at the version recorded in testdata/state
+func testStateFixture(t *testing.T, resourceType string, version int) ([]byte, map[string]interface{}) {
+	b, err := os.ReadFile(filepath.Join("testdata", "state", fmt.Sprintf("%s_v%d.json", resourceType, version)))
+	if err != nil {
+		t.Fatalf("Unexpected error: %s", err)
+	}
+
+	var rawState map[string]interface{}
+	if err := json.Unmarshal(b, &rawState); err != nil {
+		t.Fatalf("Unexpected error: %s", err)
+	}
+
+	return b, rawState
+}
+
+func TestStateUpgraders(t *testing.T) {
+	cases := []struct {
+		resourceType string
+		version      int
+	}{
+		{"ncloud_server", 0},
+		{"ncloud_public_ip", 0},
+		{"ncloud_load_balancer", 0},
+		{"ncloud_lb_target_group_attachment", 0},
+	}
+
+	for _, tc := range cases {
+		t.Run(fmt.Sprintf("%s_v%d", tc.resourceType, tc.version), func(t *testing.T) {
+			r := Provider().ResourcesMap[tc.resourceType]
+
+			var upgrader *schema.StateUpgrader
+			for i, u := range r.StateUpgraders {
+				if u.Version == tc.version {
+					upgrader = &r.StateUpgraders[i]
+				}
+			}
+			if upgrader == nil {
+				t.Fatalf("No state upgrader of version %d", tc.version)
+			}
+
+			b, rawState := testStateFixture(t, tc.resourceType, tc.version)
+			if _, err := ctyjson.Unmarshal(b, upgrader.Type); err != nil {
+				t.Fatalf("Recorded state does not match the schema of version %d: %s", tc.version, err)
+			}
+
+			actual, err := upgrader.Upgrade(context.Background(), rawState, nil)
+			if err != nil {
+				t.Fatalf("Unexpected error: %s", err)
+			}
+
+			b, expected := testStateFixture(t, tc.resourceType, tc.version+1)
+			if !reflect.DeepEqual(actual, expected) {
+				t.Fatalf("Expected: %#v, Actual: %#v", expected, actual)
+			}
+
+			if tc.version+1 == r.SchemaVersion {
+				if _, err := ctyjson.Unmarshal(b, r.CoreConfigSchema().ImpliedType()); err != nil {
+					t.Fatalf("Upgraded state does not match the current schema: %s", err)
+				}
+			}
+		})
+	}
+}
+
+func TestClearStateAttributes(t *testing.T) {
+	rawState := map[string]interface{}{
+		"deprecated": "PUBLC",
+		"name":       "tf-test",
+	}
+
+	if err := clearStateAttributes("deprecated", "missing")(rawState); err != nil {
+		t.Fatalf("Unexpected error: %s", err)
+	}
+
+	expected := map[string]interface{}{
+		"deprecated": nil,
+		"name":       "tf-test",
+	}
+	if !reflect.DeepEqual(rawState, expected) {
+		t.Fatalf("Expected: %v, Actual: %v", expected, rawState)
+	}
+}
+
+func TestSetStateId(t *testing.T) {
+	rawState := map[string]interface{}{
+		"id":              "1650000000000000000",
+		"target_group_no": "123456",
+	}
+
+	if err := setStateId("target_group_no")(rawState); err != nil {
+		t.Fatalf("Unexpected error: %s", err)
+	}
+
+	if rawState["id"] != "123456" {
+		t.Fatalf("Expected: 123456, Actual: %v", rawState["id"])
+	}
+
+	if err := setStateId("missing")(rawState); err == nil {
+		t.Fatal("Expected error for missing attribute")
+	}
+}
+
+func TestPriorResource(t *testing.T) {
+	r := &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"name":  {Type: schema.TypeString, Required: true},
+			"added": {Type: schema.TypeString, Optional: true},
+		},
+	}
+
+	prior := priorResource(r, map[string]*schema.Schema{
+		"added":   nil,
+		"removed": {Type: schema.TypeString, Optional: true},
+	})
+
+	for _, k := range []string{"name", "removed"} {
+		if _, ok := prior.Schema[k]; !ok {
+			t.Fatalf("Expected %s in the prior schema", k)
+		}
+	}
+
+	if _, ok := prior.Schema["added"]; ok {
+		t.Fatal("Expected added not in the prior schema")
+	}
+
+	if _, ok := r.Schema["removed"]; ok {
+		t.Fatal("Expected the schema of the resource unchanged")
+	}
+}
@@ -46,6 +46,12 @@ func flattenCommonCode(i interface{}) map[string]interface{} {
 	}
 }
 
+// commonCodeValue returns the code of the common code of any SDK service, or "" if it's missing from the response
+func commonCodeValue(i interface{}) string {
+	code, _ := flattenCommonCode(i)["code"].(string)
+	return code
+}
+
 func flattenAccessControlRules(accessControlRules []*server.AccessControlRule) []string {
 	var s []string
 
@@ -359,6 +365,66 @@ func expandNKSClusterLogInput(logList []interface{}) *vnks.ClusterLogInput {
 	}
 }
 
+func flattenNKSClusterOidc(oidc *NKSOidcRes) []map[string]interface{} {
+	if oidc == nil || !ncloud.BoolValue(oidc.Status) {
+		return nil
+	}
+
+	return []map[string]interface{}{
+		{
+			"issuer_url":      ncloud.StringValue(oidc.IssuerURL),
+			"client_id":       ncloud.StringValue(oidc.ClientId),
+			"username_claim":  ncloud.StringValue(oidc.UsernameClaim),
+			"username_prefix": ncloud.StringValue(oidc.UsernamePrefix),
+			"groups_claim":    ncloud.StringValue(oidc.GroupsClaim),
+			"groups_prefix":   ncloud.StringValue(oidc.GroupsPrefix),
+			"required_claim":  ncloud.StringValue(oidc.RequiredClaim),
+		},
+	}
+}
+
+func expandNKSClusterOidc(oidcList []interface{}) *NKSOidcRes {
+	if len(oidcList) == 0 || oidcList[0] == nil {
+		return &NKSOidcRes{Status: ncloud.Bool(false)}
+	}
+
+	oidc := oidcList[0].(map[string]interface{})
+	return &NKSOidcRes{
+		Status:         ncloud.Bool(true),
+		IssuerURL:      ncloud.String(oidc["issuer_url"].(string)),
+		ClientId:       ncloud.String(oidc["client_id"].(string)),
+		UsernameClaim:  StringPtrOrNil(oidc["username_claim"], oidc["username_claim"] != ""),
+		UsernamePrefix: StringPtrOrNil(oidc["username_prefix"], oidc["username_prefix"] != ""),
+		GroupsClaim:    StringPtrOrNil(oidc["groups_claim"], oidc["groups_claim"] != ""),
+		GroupsPrefix:   StringPtrOrNil(oidc["groups_prefix"], oidc["groups_prefix"] != ""),
+		RequiredClaim:  StringPtrOrNil(oidc["required_claim"], oidc["required_claim"] != ""),
+	}
+}
+
+func flattenNKSClusterIpAclEntries(entries []*NKSIpAclsEntry) (res []map[string]interface{}) {
+	for _, e := range entries {
+		res = append(res, map[string]interface{}{
+			"action":  ncloud.StringValue(e.Action),
+			"address": ncloud.StringValue(e.Address),
+			"comment": ncloud.StringValue(e.Comment),
+		})
+	}
+	return
+}
+
+func expandNKSClusterIpAclEntries(entries []interface{}) []*NKSIpAclsEntry {
+	res := make([]*NKSIpAclsEntry, 0, len(entries))
+	for _, v := range entries {
+		entry := v.(map[string]interface{})
+		res = append(res, &NKSIpAclsEntry{
+			Action:  ncloud.String(entry["action"].(string)),
+			Address: ncloud.String(entry["address"].(string)),
+			Comment: StringPtrOrNil(entry["comment"], entry["comment"] != ""),
+		})
+	}
+	return res
+}
+
 func flattenNKSNodePoolAutoScale(ao *vnks.AutoscaleOption) (res []map[string]interface{}) {
 	if ao == nil {
 		return
@@ -384,6 +450,52 @@ func expandNKSNodePoolAutoScale(as []interface{}) *vnks.AutoscalerUpdate {
 	}
 }
 
+func flattenNKSNodePoolLabels(labels []*NKSNodePoolLabel) (res []map[string]interface{}) {
+	for _, l := range labels {
+		res = append(res, map[string]interface{}{
+			"key":   ncloud.StringValue(l.Key),
+			"value": ncloud.StringValue(l.Value),
+		})
+	}
+	return
+}
+
+func expandNKSNodePoolLabels(labels []interface{}) []*NKSNodePoolLabel {
+	res := make([]*NKSNodePoolLabel, 0, len(labels))
+	for _, v := range labels {
+		label := v.(map[string]interface{})
+		res = append(res, &NKSNodePoolLabel{
+			Key:   ncloud.String(label["key"].(string)),
+			Value: ncloud.String(label["value"].(string)),
+		})
+	}
+	return res
+}
+
+func flattenNKSNodePoolTaints(taints []*NKSNodePoolTaint) (res []map[string]interface{}) {
+	for _, t := range taints {
+		res = append(res, map[string]interface{}{
+			"key":    ncloud.StringValue(t.Key),
+			"value":  ncloud.StringValue(t.Value),
+			"effect": ncloud.StringValue(t.Effect),
+		})
+	}
+	return
+}
+
+func expandNKSNodePoolTaints(taints []interface{}) []*NKSNodePoolTaint {
+	res := make([]*NKSNodePoolTaint, 0, len(taints))
+	for _, v := range taints {
+		taint := v.(map[string]interface{})
+		res = append(res, &NKSNodePoolTaint{
+			Key:    ncloud.String(taint["key"].(string)),
+			Value:  ncloud.String(taint["value"].(string)),
+			Effect: ncloud.String(taint["effect"].(string)),
+		})
+	}
+	return res
+}
+
 func expandSourceBuildEnvVarsParams(eVars []interface{}) ([]*sourcebuild.ProjectEnvEnvVars, error) {
 	envVars := make([]*sourcebuild.ProjectEnvEnvVars, 0, len(eVars))
 
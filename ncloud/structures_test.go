@@ -51,6 +51,22 @@ func TestFlattenCommonCode(t *testing.T) {
 	}
 }
 
+func TestCommonCodeValue(t *testing.T) {
+	if code := commonCodeValue(&server.CommonCode{Code: ncloud.String("RUN")}); code != "RUN" {
+		t.Fatalf("expected RUN, but was %s", code)
+	}
+
+	// Status missing from the response
+	var missing *server.CommonCode
+	if code := commonCodeValue(missing); code != "" {
+		t.Fatalf("expected empty code, but was %s", code)
+	}
+
+	if code := commonCodeValue(&server.CommonCode{}); code != "" {
+		t.Fatalf("expected empty code, but was %s", code)
+	}
+}
+
 func TestFlattenAccessControlRules(t *testing.T) {
 	expected := []*server.AccessControlRule{
 		{
@@ -748,6 +764,111 @@ func TestExpandNKSClusterLogInput(t *testing.T) {
 	}
 }
 
+func TestFlattenNKSClusterOidc(t *testing.T) {
+	oidc := &NKSOidcRes{
+		Status:        ncloud.Bool(true),
+		IssuerURL:     ncloud.String("https://sso.example.com"),
+		ClientId:      ncloud.String("kubernetes"),
+		UsernameClaim: ncloud.String("email"),
+	}
+
+	result := flattenNKSClusterOidc(oidc)
+
+	if len(result) != 1 {
+		t.Fatalf("expected result had %d elements, but got %d", 1, len(result))
+	}
+
+	r := result[0]
+	if r["issuer_url"].(string) != "https://sso.example.com" {
+		t.Fatalf("expected result issuer_url to be https://sso.example.com, but was %s", r["issuer_url"])
+	}
+
+	if r["username_claim"].(string) != "email" {
+		t.Fatalf("expected result username_claim to be email, but was %s", r["username_claim"])
+	}
+
+	if result := flattenNKSClusterOidc(&NKSOidcRes{Status: ncloud.Bool(false)}); result != nil {
+		t.Fatalf("expected nil for disabled oidc, but got %v", result)
+	}
+}
+
+func TestExpandNKSClusterOidc(t *testing.T) {
+	oidc := []interface{}{
+		map[string]interface{}{
+			"issuer_url":      "https://sso.example.com",
+			"client_id":       "kubernetes",
+			"username_claim":  "email",
+			"username_prefix": "",
+			"groups_claim":    "groups",
+			"groups_prefix":   "",
+			"required_claim":  "",
+		},
+	}
+
+	result := expandNKSClusterOidc(oidc)
+
+	if !ncloud.BoolValue(result.Status) {
+		t.Fatal("expected status true, but got false")
+	}
+
+	if ncloud.StringValue(result.ClientId) != "kubernetes" {
+		t.Fatalf("expected kubernetes , but got %s", ncloud.StringValue(result.ClientId))
+	}
+
+	if result.UsernamePrefix != nil {
+		t.Fatalf("expected nil username prefix, but got %s", ncloud.StringValue(result.UsernamePrefix))
+	}
+
+	if result := expandNKSClusterOidc([]interface{}{}); ncloud.BoolValue(result.Status) {
+		t.Fatal("expected status false for empty oidc, but got true")
+	}
+}
+
+func TestFlattenNKSClusterIpAclEntries(t *testing.T) {
+	entries := []*NKSIpAclsEntry{
+		{Address: ncloud.String("10.0.0.0/8"), Action: ncloud.String("allow"), Comment: ncloud.String("office")},
+	}
+
+	result := flattenNKSClusterIpAclEntries(entries)
+
+	if len(result) != 1 {
+		t.Fatalf("expected result had %d elements, but got %d", 1, len(result))
+	}
+
+	r := result[0]
+	if r["address"].(string) != "10.0.0.0/8" {
+		t.Fatalf("expected result address to be 10.0.0.0/8, but was %s", r["address"])
+	}
+
+	if r["action"].(string) != "allow" {
+		t.Fatalf("expected result action to be allow, but was %s", r["action"])
+	}
+}
+
+func TestExpandNKSClusterIpAclEntries(t *testing.T) {
+	entries := []interface{}{
+		map[string]interface{}{
+			"address": "10.0.0.0/8",
+			"action":  "deny",
+			"comment": "",
+		},
+	}
+
+	result := expandNKSClusterIpAclEntries(entries)
+
+	if len(result) != 1 {
+		t.Fatalf("expected result had %d elements, but got %d", 1, len(result))
+	}
+
+	if ncloud.StringValue(result[0].Action) != "deny" {
+		t.Fatalf("expected deny , but got %s", ncloud.StringValue(result[0].Action))
+	}
+
+	if result[0].Comment != nil {
+		t.Fatalf("expected nil comment, but got %s", ncloud.StringValue(result[0].Comment))
+	}
+}
+
 func TestFlattenNKSNodePoolAutoscale(t *testing.T) {
 	expanded := &vnks.AutoscaleOption{
 		Enabled: ncloud.Bool(true),
@@ -803,6 +924,91 @@ func TestExpandNKSNodePoolAutoScale(t *testing.T) {
 	}
 }
 
+func TestFlattenNKSNodePoolLabels(t *testing.T) {
+	labels := []*NKSNodePoolLabel{
+		{Key: ncloud.String("role"), Value: ncloud.String("gpu")},
+	}
+
+	result := flattenNKSNodePoolLabels(labels)
+
+	if len(result) != 1 {
+		t.Fatalf("expected result had %d elements, but got %d", 1, len(result))
+	}
+
+	r := result[0]
+	if r["key"].(string) != "role" {
+		t.Fatalf("expected result key to be role, but was %s", r["key"])
+	}
+
+	if r["value"].(string) != "gpu" {
+		t.Fatalf("expected result value to be gpu, but was %s", r["value"])
+	}
+}
+
+func TestExpandNKSNodePoolLabels(t *testing.T) {
+	labels := []interface{}{
+		map[string]interface{}{
+			"key":   "role",
+			"value": "gpu",
+		},
+	}
+
+	result := expandNKSNodePoolLabels(labels)
+
+	if len(result) != 1 {
+		t.Fatalf("expected result had %d elements, but got %d", 1, len(result))
+	}
+
+	if ncloud.StringValue(result[0].Key) != "role" {
+		t.Fatalf("expected role , but got %s", ncloud.StringValue(result[0].Key))
+	}
+
+	if ncloud.StringValue(result[0].Value) != "gpu" {
+		t.Fatalf("expected gpu , but got %s", ncloud.StringValue(result[0].Value))
+	}
+}
+
+func TestFlattenNKSNodePoolTaints(t *testing.T) {
+	taints := []*NKSNodePoolTaint{
+		{Key: ncloud.String("dedicated"), Value: ncloud.String("gpu"), Effect: ncloud.String("NoSchedule")},
+	}
+
+	result := flattenNKSNodePoolTaints(taints)
+
+	if len(result) != 1 {
+		t.Fatalf("expected result had %d elements, but got %d", 1, len(result))
+	}
+
+	r := result[0]
+	if r["key"].(string) != "dedicated" {
+		t.Fatalf("expected result key to be dedicated, but was %s", r["key"])
+	}
+
+	if r["effect"].(string) != "NoSchedule" {
+		t.Fatalf("expected result effect to be NoSchedule, but was %s", r["effect"])
+	}
+}
+
+func TestExpandNKSNodePoolTaints(t *testing.T) {
+	taints := []interface{}{
+		map[string]interface{}{
+			"key":    "dedicated",
+			"value":  "gpu",
+			"effect": "NoExecute",
+		},
+	}
+
+	result := expandNKSNodePoolTaints(taints)
+
+	if len(result) != 1 {
+		t.Fatalf("expected result had %d elements, but got %d", 1, len(result))
+	}
+
+	if ncloud.StringValue(result[0].Effect) != "NoExecute" {
+		t.Fatalf("expected NoExecute , but got %s", ncloud.StringValue(result[0].Effect))
+	}
+}
+
 func TestExpandSourceBuildEnvVarsParams(t *testing.T) {
 	envVars := []interface{}{
 		map[string]interface{}{
@@ -0,0 +1,144 @@
+package ncloud
+
+import (
+	"sort"
+
+	"github.com/NaverCloudPlatform/ncloud-sdk-go-v2/ncloud"
+	"github.com/NaverCloudPlatform/ncloud-sdk-go-v2/services/server"
+)
+
+// expandProviderDefaultTags returns tags of the provider `default_tags` block
+func expandProviderDefaultTags(l []interface{}) map[string]string {
+	tags := map[string]string{}
+
+	if len(l) == 0 || l[0] == nil {
+		return tags
+	}
+
+	for k, v := range l[0].(map[string]interface{})["tags"].(map[string]interface{}) {
+		tags[k] = v.(string)
+	}
+
+	return tags
+}
+
+// mergeDefaultTagList merges default tags of the provider into `tag_list` of the resource.
+// Tags of the resource take precedence over the default tags with the same key.
+func mergeDefaultTagList(defaultTags map[string]string, tagList []interface{}) []interface{} {
+	merged := make([]interface{}, 0, len(defaultTags)+len(tagList))
+	keys := map[string]bool{}
+
+	for _, v := range tagList {
+		m := v.(map[string]interface{})
+		keys[m["tag_key"].(string)] = true
+		merged = append(merged, m)
+	}
+
+	var defaultKeys []string
+	for k := range defaultTags {
+		if !keys[k] {
+			defaultKeys = append(defaultKeys, k)
+		}
+	}
+	sort.Strings(defaultKeys)
+
+	for _, k := range defaultKeys {
+		merged = append(merged, map[string]interface{}{
+			"tag_key":   k,
+			"tag_value": defaultTags[k],
+		})
+	}
+
+	return merged
+}
+
+// tagListKeys returns keys of `tag_list`
+func tagListKeys(tagList []interface{}) map[string]bool {
+	keys := map[string]bool{}
+	for _, v := range tagList {
+		keys[v.(map[string]interface{})["tag_key"].(string)] = true
+	}
+	return keys
+}
+
+// tagListToMap returns tags of `tag_list` as map of key and value
+func tagListToMap(tagList []interface{}) map[string]string {
+	tags := map[string]string{}
+	for _, v := range tagList {
+		m := v.(map[string]interface{})
+		tags[m["tag_key"].(string)] = m["tag_value"].(string)
+	}
+	return tags
+}
+
+// diffTagList returns tags to create and to delete to change the tags from oldTagList to newTagList.
+// Tags with a changed value are deleted and created again.
+func diffTagList(oldTagList []interface{}, newTagList []interface{}) ([]*server.InstanceTagParameter, []*server.InstanceTagParameter) {
+	oldTags := tagListToMap(oldTagList)
+	newTags := tagListToMap(newTagList)
+
+	var create, remove []*server.InstanceTagParameter
+
+	var oldKeys []string
+	for k := range oldTags {
+		oldKeys = append(oldKeys, k)
+	}
+	sort.Strings(oldKeys)
+
+	for _, k := range oldKeys {
+		if v, ok := newTags[k]; !ok || v != oldTags[k] {
+			remove = append(remove, &server.InstanceTagParameter{TagKey: ncloud.String(k), TagValue: ncloud.String(oldTags[k])})
+		}
+	}
+
+	var newKeys []string
+	for k := range newTags {
+		newKeys = append(newKeys, k)
+	}
+	sort.Strings(newKeys)
+
+	for _, k := range newKeys {
+		if v, ok := oldTags[k]; !ok || v != newTags[k] {
+			create = append(create, &server.InstanceTagParameter{TagKey: ncloud.String(k), TagValue: ncloud.String(newTags[k])})
+		}
+	}
+
+	return create, remove
+}
+
+// managedTagKeys returns the keys of `tag_list` and of `tag_list_all` to compare with the tags of the instance
+func managedTagKeys(tagList []interface{}, defaultTags map[string]string) (map[string]bool, map[string]bool) {
+	keys := tagListKeys(tagList)
+
+	allKeys := map[string]bool{}
+	for k := range keys {
+		allKeys[k] = true
+	}
+	for k := range defaultTags {
+		allKeys[k] = true
+	}
+
+	return keys, allKeys
+}
+
+// importedTagList returns the tags of the instance not inherited from the default tags, taken as `tag_list` on import
+func importedTagList(instanceTagList []*server.InstanceTag, defaultTags map[string]string) []*server.InstanceTag {
+	imported := make([]*server.InstanceTag, 0, len(instanceTagList))
+	for _, tag := range instanceTagList {
+		if _, ok := defaultTags[ncloud.StringValue(tag.TagKey)]; !ok {
+			imported = append(imported, tag)
+		}
+	}
+	return imported
+}
+
+// filterInstanceTagList returns the tags with the given keys so that tags added outside of Terraform don't show up as drift
+func filterInstanceTagList(tagList []*server.InstanceTag, keys map[string]bool) []*server.InstanceTag {
+	filtered := make([]*server.InstanceTag, 0, len(tagList))
+	for _, tag := range tagList {
+		if keys[ncloud.StringValue(tag.TagKey)] {
+			filtered = append(filtered, tag)
+		}
+	}
+	return filtered
+}
@@ -0,0 +1,142 @@
+package ncloud
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/NaverCloudPlatform/ncloud-sdk-go-v2/ncloud"
+	"github.com/NaverCloudPlatform/ncloud-sdk-go-v2/services/server"
+)
+
+func TestExpandProviderDefaultTags(t *testing.T) {
+	tags := expandProviderDefaultTags([]interface{}{
+		map[string]interface{}{
+			"tags": map[string]interface{}{
+				"owner": "platform",
+				"env":   "dev",
+			},
+		},
+	})
+
+	expected := map[string]string{"owner": "platform", "env": "dev"}
+	if !reflect.DeepEqual(tags, expected) {
+		t.Fatalf("Expected: %v, Actual: %v", expected, tags)
+	}
+
+	if tags := expandProviderDefaultTags([]interface{}{}); len(tags) != 0 {
+		t.Fatalf("Expected empty tags, Actual: %v", tags)
+	}
+}
+
+func TestMergeDefaultTagList(t *testing.T) {
+	merged := mergeDefaultTagList(
+		map[string]string{"owner": "platform", "env": "dev", "cost": "1234"},
+		[]interface{}{
+			map[string]interface{}{"tag_key": "env", "tag_value": "prod"},
+			map[string]interface{}{"tag_key": "name", "tag_value": "web"},
+		},
+	)
+
+	expected := []interface{}{
+		map[string]interface{}{"tag_key": "env", "tag_value": "prod"},
+		map[string]interface{}{"tag_key": "name", "tag_value": "web"},
+		map[string]interface{}{"tag_key": "cost", "tag_value": "1234"},
+		map[string]interface{}{"tag_key": "owner", "tag_value": "platform"},
+	}
+
+	if !reflect.DeepEqual(merged, expected) {
+		t.Fatalf("Expected: %v, Actual: %v", expected, merged)
+	}
+}
+
+func TestFilterInstanceTagList(t *testing.T) {
+	tagList := []*server.InstanceTag{
+		{TagKey: ncloud.String("owner"), TagValue: ncloud.String("platform")},
+		{TagKey: ncloud.String("added-by-console"), TagValue: ncloud.String("true")},
+		{TagKey: ncloud.String("env"), TagValue: ncloud.String("dev")},
+	}
+
+	filtered := filterInstanceTagList(tagList, map[string]bool{"owner": true, "env": true})
+
+	if len(filtered) != 2 {
+		t.Fatalf("Expected 2 tags, Actual: %d", len(filtered))
+	}
+
+	if ncloud.StringValue(filtered[0].TagKey) != "owner" || ncloud.StringValue(filtered[1].TagKey) != "env" {
+		t.Fatalf("Expected owner, env, Actual: %s, %s", ncloud.StringValue(filtered[0].TagKey), ncloud.StringValue(filtered[1].TagKey))
+	}
+}
+
+func TestManagedTagKeys(t *testing.T) {
+	keys, allKeys := managedTagKeys([]interface{}{
+		map[string]interface{}{"tag_key": "name", "tag_value": "web"},
+	}, map[string]string{"owner": "platform"})
+
+	if expected := map[string]bool{"name": true}; !reflect.DeepEqual(keys, expected) {
+		t.Fatalf("Expected: %v, Actual: %v", expected, keys)
+	}
+	if expected := map[string]bool{"name": true, "owner": true}; !reflect.DeepEqual(allKeys, expected) {
+		t.Fatalf("Expected: %v, Actual: %v", expected, allKeys)
+	}
+
+	// Tags of the server are never adopted on read, so tags added outside of Terraform stay unmanaged
+	keys, _ = managedTagKeys([]interface{}{}, map[string]string{"owner": "platform"})
+	if len(keys) != 0 {
+		t.Fatalf("Expected no keys, Actual: %v", keys)
+	}
+}
+
+func TestImportedTagList(t *testing.T) {
+	instanceTagList := []*server.InstanceTag{
+		{TagKey: ncloud.String("owner"), TagValue: ncloud.String("platform")},
+		{TagKey: ncloud.String("name"), TagValue: ncloud.String("web")},
+		{TagKey: ncloud.String("added-by-console"), TagValue: ncloud.String("true")},
+	}
+
+	imported := importedTagList(instanceTagList, map[string]string{"owner": "platform"})
+	if len(imported) != 2 || ncloud.StringValue(imported[0].TagKey) != "name" || ncloud.StringValue(imported[1].TagKey) != "added-by-console" {
+		t.Fatalf("Expected name, added-by-console, Actual: %v", ConvertToArrayMap(imported))
+	}
+}
+
+func TestDiffTagList(t *testing.T) {
+	create, remove := diffTagList(
+		[]interface{}{
+			map[string]interface{}{"tag_key": "owner", "tag_value": "platform"},
+			map[string]interface{}{"tag_key": "env", "tag_value": "dev"},
+			map[string]interface{}{"tag_key": "temp", "tag_value": "true"},
+		},
+		[]interface{}{
+			map[string]interface{}{"tag_key": "owner", "tag_value": "platform"},
+			map[string]interface{}{"tag_key": "env", "tag_value": "prod"},
+			map[string]interface{}{"tag_key": "name", "tag_value": "web"},
+		},
+	)
+
+	expectedCreate := []*server.InstanceTagParameter{
+		{TagKey: ncloud.String("env"), TagValue: ncloud.String("prod")},
+		{TagKey: ncloud.String("name"), TagValue: ncloud.String("web")},
+	}
+	if !reflect.DeepEqual(create, expectedCreate) {
+		t.Fatalf("Expected create: %v, Actual: %v", ConvertToArrayMap(expectedCreate), ConvertToArrayMap(create))
+	}
+
+	expectedRemove := []*server.InstanceTagParameter{
+		{TagKey: ncloud.String("env"), TagValue: ncloud.String("dev")},
+		{TagKey: ncloud.String("temp"), TagValue: ncloud.String("true")},
+	}
+	if !reflect.DeepEqual(remove, expectedRemove) {
+		t.Fatalf("Expected remove: %v, Actual: %v", ConvertToArrayMap(expectedRemove), ConvertToArrayMap(remove))
+	}
+}
+
+func TestDiffTagList_noChange(t *testing.T) {
+	tagList := []interface{}{
+		map[string]interface{}{"tag_key": "owner", "tag_value": "platform"},
+	}
+
+	create, remove := diffTagList(tagList, tagList)
+	if len(create) != 0 || len(remove) != 0 {
+		t.Fatalf("Expected no changes, Actual create: %d, remove: %d", len(create), len(remove))
+	}
+}
@@ -0,0 +1,175 @@
+package ncloud
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+const (
+	DefaultWaiterMinDelay = 2 * time.Second
+	DefaultWaiterMaxDelay = 20 * time.Second
+
+	// DefaultWaiterNotFoundStatus status of the instance which is not found
+	DefaultWaiterNotFoundStatus = "TERMINATED"
+)
+
+// Waiter waits for an instance of type T to reach one of the target statuses, polling with exponential backoff
+type Waiter[T any] struct {
+	// Name of the instance in logs, e.g. "VPC (1234)"
+	Name    string
+	Pending []string
+	Target  []string
+
+	// Refresh returns the instance, or nil if not found
+	Refresh func() (*T, error)
+	// Status returns the status of the instance
+	Status func(*T) string
+	// Operation returns the operation in progress of the instance. Optional, it's logged and included in the error of the wait.
+	Operation func(*T) string
+	// NotFoundStatus status of the instance not found. Default "TERMINATED"
+	NotFoundStatus string
+
+	Timeout time.Duration
+	// Delay before the first refresh
+	Delay time.Duration
+	// MinDelay and MaxDelay of the backoff between refreshes. Default DefaultWaiterMinDelay and DefaultWaiterMaxDelay
+	MinDelay time.Duration
+	MaxDelay time.Duration
+}
+
+// WaitStateError error of the wait ended before the instance reached the target statuses, with the last observed status and operation
+type WaitStateError struct {
+	Target        []string
+	LastStatus    string
+	LastOperation string
+	// Err is context.DeadlineExceeded or context.Canceled if the wait timed out or was canceled, or nil for an unexpected status
+	Err error
+}
+
+func (e *WaitStateError) Error() string {
+	last := fmt.Sprintf("last status: %q", e.LastStatus)
+	if e.LastOperation != "" {
+		last += fmt.Sprintf(", last operation: %q", e.LastOperation)
+	}
+
+	target := strings.Join(e.Target, ", ")
+	switch {
+	case errors.Is(e.Err, context.DeadlineExceeded):
+		return fmt.Sprintf("timeout while waiting for status to become %s (%s)", target, last)
+	case e.Err != nil:
+		return fmt.Sprintf("%s while waiting for status to become %s (%s)", e.Err, target, last)
+	default:
+		return fmt.Sprintf("unexpected status while waiting for status to become %s (%s)", target, last)
+	}
+}
+
+func (e *WaitStateError) Unwrap() error {
+	return e.Err
+}
+
+// Wait returns the instance in one of the target statuses, or nil if it's not found and the not found status is a target.
+func (w *Waiter[T]) Wait(ctx context.Context) (*T, error) {
+	if w.Timeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, w.Timeout)
+		defer cancel()
+	}
+
+	name := w.Name
+	if name == "" {
+		name = "instance"
+	}
+
+	notFoundStatus := w.NotFoundStatus
+	if notFoundStatus == "" {
+		notFoundStatus = DefaultWaiterNotFoundStatus
+	}
+
+	minDelay, maxDelay := w.MinDelay, w.MaxDelay
+	if minDelay <= 0 {
+		minDelay = DefaultWaiterMinDelay
+	}
+	if maxDelay <= 0 {
+		maxDelay = DefaultWaiterMaxDelay
+	}
+	if maxDelay < minDelay {
+		maxDelay = minDelay
+	}
+
+	start := time.Now()
+	var lastStatus, lastOperation string
+	wait := func(delay time.Duration) error {
+		if err := sleepContext(ctx, delay); err != nil {
+			return &WaitStateError{Target: w.Target, LastStatus: lastStatus, LastOperation: lastOperation, Err: err}
+		}
+		return nil
+	}
+
+	log.Printf("[DEBUG] Waiting for %s to become %s", name, strings.Join(w.Target, ", "))
+	if err := wait(w.Delay); err != nil {
+		return nil, err
+	}
+
+	delay := minDelay
+	for attempt := 1; ; attempt++ {
+		instance, err := w.Refresh()
+		if err != nil {
+			return nil, err
+		}
+
+		status, operation := notFoundStatus, ""
+		if instance != nil {
+			status = w.Status(instance)
+			if w.Operation != nil {
+				operation = w.Operation(instance)
+			}
+		}
+
+		if status != lastStatus || operation != lastOperation || attempt == 1 {
+			log.Printf("[INFO] %s status: %s, operation: %s (elapsed %s)", name, status, operation, time.Since(start).Round(time.Second))
+		}
+		lastStatus, lastOperation = status, operation
+
+		if containsInStringList(status, w.Target) {
+			return instance, nil
+		}
+
+		if !containsInStringList(status, w.Pending) {
+			return nil, &WaitStateError{Target: w.Target, LastStatus: status, LastOperation: operation}
+		}
+
+		log.Printf("[DEBUG] Waiting %s before refreshing %s (attempt %d)", delay, name, attempt)
+		if err := wait(delay); err != nil {
+			return nil, err
+		}
+
+		if delay *= 2; delay > maxDelay {
+			delay = maxDelay
+		}
+	}
+}
+
+// sleepContext waits for the delay, or returns the error of the context done before it
+func sleepContext(ctx context.Context, delay time.Duration) error {
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-time.After(delay):
+		return nil
+	}
+}
+
+// retryableErrorAfter returns the retryable error after the delay, for the API calls rejected while the instance is in operation.
+// If the context is done first, the error of the context is returned as non-retryable.
+func retryableErrorAfter(ctx context.Context, delay time.Duration, err error) *resource.RetryError {
+	if ctxErr := sleepContext(ctx, delay); ctxErr != nil {
+		return resource.NonRetryableError(ctxErr)
+	}
+	return resource.RetryableError(err)
+}
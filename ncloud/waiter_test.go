@@ -0,0 +1,172 @@
+package ncloud
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+)
+
+type testWaiterInstance struct {
+	status    string
+	operation string
+}
+
+// testWaiter returns a waiter refreshing the instances in order, repeating the last one
+func testWaiter(instances ...*testWaiterInstance) (*Waiter[testWaiterInstance], *[]time.Time) {
+	var refreshed []time.Time
+	return &Waiter[testWaiterInstance]{
+		Name:    "test",
+		Pending: []string{"INIT", "CREAT"},
+		Target:  []string{"RUN"},
+		Refresh: func() (*testWaiterInstance, error) {
+			refreshed = append(refreshed, time.Now())
+			if len(refreshed) > len(instances) {
+				return instances[len(instances)-1], nil
+			}
+			return instances[len(refreshed)-1], nil
+		},
+		Status: func(i *testWaiterInstance) string {
+			return i.status
+		},
+		Operation: func(i *testWaiterInstance) string {
+			return i.operation
+		},
+		Timeout:  time.Second,
+		MinDelay: time.Millisecond,
+		MaxDelay: 5 * time.Millisecond,
+	}, &refreshed
+}
+
+func TestWaiter_target(t *testing.T) {
+	w, refreshed := testWaiter(&testWaiterInstance{status: "INIT"}, &testWaiterInstance{status: "CREAT"}, &testWaiterInstance{status: "RUN"})
+
+	instance, err := w.Wait(context.Background())
+	if err != nil {
+		t.Fatalf("Unexpected error: %s", err)
+	}
+
+	if instance.status != "RUN" {
+		t.Fatalf("Expected: %s, Actual: %s", "RUN", instance.status)
+	}
+
+	if len(*refreshed) != 3 {
+		t.Fatalf("Expected: %d, Actual: %d", 3, len(*refreshed))
+	}
+}
+
+func TestWaiter_unexpectedStatus(t *testing.T) {
+	w, _ := testWaiter(&testWaiterInstance{status: "INIT"}, &testWaiterInstance{status: "NSTOP", operation: "SETUP"})
+
+	_, err := w.Wait(context.Background())
+
+	var waitErr *WaitStateError
+	if !errors.As(err, &waitErr) {
+		t.Fatalf("Expected WaitStateError, Actual: %v", err)
+	}
+
+	if waitErr.LastStatus != "NSTOP" || waitErr.LastOperation != "SETUP" || waitErr.Err != nil {
+		t.Fatalf("Expected last status NSTOP and operation SETUP, Actual: %#v", waitErr)
+	}
+
+	if !strings.Contains(err.Error(), `last operation: "SETUP"`) {
+		t.Fatalf("Expected the last operation in the error, Actual: %s", err)
+	}
+}
+
+func TestWaiter_timeout(t *testing.T) {
+	w, _ := testWaiter(&testWaiterInstance{status: "CREAT", operation: "CREAT"})
+	w.Timeout = 20 * time.Millisecond
+
+	_, err := w.Wait(context.Background())
+	if !errors.Is(err, context.DeadlineExceeded) {
+		t.Fatalf("Expected: %s, Actual: %v", context.DeadlineExceeded, err)
+	}
+
+	if !strings.HasPrefix(err.Error(), "timeout while waiting for status to become RUN") {
+		t.Fatalf("Unexpected error message: %s", err)
+	}
+}
+
+func TestWaiter_cancel(t *testing.T) {
+	w, _ := testWaiter(&testWaiterInstance{status: "INIT"})
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	if _, err := w.Wait(ctx); !errors.Is(err, context.Canceled) {
+		t.Fatalf("Expected: %s, Actual: %v", context.Canceled, err)
+	}
+}
+
+func TestWaiter_refreshError(t *testing.T) {
+	w, _ := testWaiter(&testWaiterInstance{status: "INIT"})
+	expected := errors.New("refresh error")
+	w.Refresh = func() (*testWaiterInstance, error) {
+		return nil, expected
+	}
+
+	if _, err := w.Wait(context.Background()); err != expected {
+		t.Fatalf("Expected: %s, Actual: %v", expected, err)
+	}
+}
+
+func TestWaiter_notFound(t *testing.T) {
+	w, _ := testWaiter(&testWaiterInstance{status: "RUN"}, nil)
+	w.Pending = []string{"RUN"}
+	w.Target = []string{DefaultWaiterNotFoundStatus}
+
+	instance, err := w.Wait(context.Background())
+	if err != nil {
+		t.Fatalf("Unexpected error: %s", err)
+	}
+	if instance != nil {
+		t.Fatalf("Expected nil, Actual: %v", instance)
+	}
+
+	w, _ = testWaiter(nil)
+	w.NotFoundStatus = "NULL"
+	w.Target = []string{"NULL"}
+
+	if _, err := w.Wait(context.Background()); err != nil {
+		t.Fatalf("Unexpected error: %s", err)
+	}
+}
+
+func TestWaiter_backoff(t *testing.T) {
+	w, refreshed := testWaiter(&testWaiterInstance{status: "INIT"}, &testWaiterInstance{status: "INIT"}, &testWaiterInstance{status: "INIT"}, &testWaiterInstance{status: "INIT"}, &testWaiterInstance{status: "INIT"}, &testWaiterInstance{status: "RUN"})
+	w.MinDelay = 10 * time.Millisecond
+	w.MaxDelay = 40 * time.Millisecond
+
+	if _, err := w.Wait(context.Background()); err != nil {
+		t.Fatalf("Unexpected error: %s", err)
+	}
+
+	expected := []time.Duration{10, 20, 40, 40, 40}
+	for i, delay := range expected {
+		if actual := (*refreshed)[i+1].Sub((*refreshed)[i]); actual < delay*time.Millisecond {
+			t.Fatalf("Expected delay of at least %dms before refresh %d, Actual: %s", delay, i+2, actual)
+		}
+	}
+}
+
+func TestRetryableErrorAfter(t *testing.T) {
+	apiErr := errors.New("in operation")
+
+	if retryErr := retryableErrorAfter(context.Background(), time.Millisecond, apiErr); !retryErr.Retryable || retryErr.Err != apiErr {
+		t.Fatalf("Expected retryable: %s, Actual: %#v", apiErr, retryErr)
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	start := time.Now()
+	retryErr := retryableErrorAfter(ctx, time.Minute, apiErr)
+	if retryErr.Retryable || !errors.Is(retryErr.Err, context.Canceled) {
+		t.Fatalf("Expected non-retryable: %s, Actual: %#v", context.Canceled, retryErr)
+	}
+	if time.Since(start) > time.Second {
+		t.Fatal("Expected to return without waiting for the delay")
+	}
+}
@@ -16,13 +16,11 @@ type Zone struct {
 	RegionCode      *string `json:"regionCode,omitempty"`
 }
 
-var zoneCache = make(map[string]string)
-
 func parseZoneNoParameter(config *ProviderConfig, d *schema.ResourceData) (*string, error) {
 	if zoneCode, zoneCodeOk := d.GetOk("zone"); zoneCodeOk {
 		zoneNo := getZoneNoByCode(config, zoneCode.(string))
 		if zoneNo == "" {
-			return nil, fmt.Errorf("no zone data for zone_code `%s`. please change zone_code and try again", zoneCode.(string))
+			return nil, NewArgumentError("zone", fmt.Errorf("no zone data for zone_code `%s`. please change zone_code and try again", zoneCode.(string)))
 		}
 		return ncloud.String(zoneNo), nil
 
@@ -31,22 +29,14 @@ func parseZoneNoParameter(config *ProviderConfig, d *schema.ResourceData) (*stri
 }
 
 func getZoneNoByCode(config *ProviderConfig, code string) string {
-	if zoneNo := zoneCache[code]; zoneNo != "" {
-		return zoneNo
-	}
 	if zone, err := getZoneByCode(config, code); err == nil && zone != nil {
-		zoneCache[code] = *zone.ZoneNo
 		return *zone.ZoneNo
 	}
 	return ""
 }
 
 func getZoneCodeByNo(config *ProviderConfig, no string) string {
-	if zoneCode := zoneCache[no]; zoneCode != "" {
-		return zoneCode
-	}
 	if zone, err := getZoneByNo(config, no); err == nil && zone != nil {
-		zoneCache[no] = *zone.ZoneCode
 		return *zone.ZoneCode
 	}
 	return ""
@@ -85,15 +75,7 @@ func getZoneByCode(config *ProviderConfig, code string) (*Zone, error) {
 }
 
 func getZones(config *ProviderConfig) ([]*Zone, error) {
-	var zones []*Zone
-	var err error
-
-	if config.SupportVPC == true {
-		zones, err = getVpcZones(config)
-	} else {
-		zones, err = getClassicZones(config)
-	}
-
+	zones, err := config.regionCache.getZones()
 	if err != nil {
 		return nil, err
 	}